@@ -0,0 +1,385 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/config"
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/Falokut/admin_movies_persons_service/internal/service"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	image_processing_service "github.com/Falokut/image_processing_service/pkg/image_processing_service/v1/protos"
+	image_storage_service "github.com/Falokut/images_storage_service/pkg/images_storage_service/v1/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Operations used by the commands, implemented by the grpc client and by the service, that works with the database
+type personsAPI interface {
+	GetPersonsV2(ctx context.Context, in *movies_persons_service.GetPersonsRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.PersonsList, error)
+	GetPerson(ctx context.Context, in *movies_persons_service.GetPersonRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.PersonDetails, error)
+	SearchPersonByNameV2(ctx context.Context, in *movies_persons_service.SearchPersonByNameRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.PersonsList, error)
+	CreatePerson(ctx context.Context, in *movies_persons_service.CreatePersonRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.CreatePersonResponce, error)
+	BatchCreatePersons(ctx context.Context, in *movies_persons_service.BatchCreatePersonsRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.BatchCreatePersonsResponse, error)
+	UpdatePersonFields(ctx context.Context, in *movies_persons_service.UpdatePersonFieldsRequest,
+		opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeletePersons(ctx context.Context, in *movies_persons_service.DeletePersonsRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.DeletePersonsResponce, error)
+	RestorePersons(ctx context.Context, in *movies_persons_service.RestorePersonsRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.RestorePersonsResponse, error)
+	FindPotentialDuplicates(ctx context.Context, in *movies_persons_service.FindPotentialDuplicatesRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.PotentialDuplicates, error)
+	ReemitPersonsEvents(ctx context.Context, in *movies_persons_service.ReemitPersonsEventsRequest,
+		opts ...grpc.CallOption) (*movies_persons_service.ReemitPersonsEventsResponse, error)
+	ImportPersons(ctx context.Context,
+		opts ...grpc.CallOption) (movies_persons_service.MoviesPersonsServiceV1_ImportPersonsClient, error)
+	ExportPersons(ctx context.Context, in *movies_persons_service.ExportPersonsRequest,
+		opts ...grpc.CallOption) (movies_persons_service.MoviesPersonsServiceV1_ExportPersonsClient, error)
+	WatchPersons(ctx context.Context, in *movies_persons_service.WatchPersonsRequest,
+		opts ...grpc.CallOption) (movies_persons_service.MoviesPersonsServiceV1_WatchPersonsClient, error)
+}
+
+// Connects to the running service, returned func closes the connection
+func newGRPCBackend(addr string, secure bool) (personsAPI, func(), error) {
+	connCfg := config.ConnectionSecureConfig{Method: config.Insecure}
+	if secure {
+		connCfg.Method = config.ClientWithSystemCertPool
+	}
+	conn, err := dial(addr, connCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return movies_persons_service.NewMoviesPersonsServiceV1Client(conn), func() { conn.Close() }, nil
+}
+
+// Creates the service, that works with the database and the images services from the service config,
+// returned func closes the connections
+func newDBBackend(cfg *config.Config, logger *logrus.Logger) (personsAPI, func(), error) {
+	var closers []func()
+	closeAll := func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}
+
+	idempotencyCfg := service.IdempotencyConfig{
+		KeyTTL:         cfg.IdempotencyConfig.KeyTTL,
+		PendingTimeout: cfg.IdempotencyConfig.PendingTimeout,
+	}
+	if err := idempotencyCfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid idempotency config: %w", err)
+	}
+	watchCfg := service.WatchConfig{
+		PollInterval: cfg.WatchConfig.PollInterval,
+		BatchSize:    cfg.WatchConfig.BatchSize,
+	}
+	if err := watchCfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid watch config: %w", err)
+	}
+
+	database, err := repository.NewPostgreDB(cfg.DBConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	repo := repository.NewPersonsRepository(database, logger)
+	closers = append(closers, repo.Shutdown)
+
+	storageConn, err := dial(cfg.ImageStorageService.StorageAddr, cfg.ImageStorageService.ConnectionConfig)
+	if err != nil {
+		closeAll()
+		return nil, nil, err
+	}
+	closers = append(closers, func() { storageConn.Close() })
+
+	processingConn, err := dial(cfg.ImageProcessingService.Addr, cfg.ImageProcessingService.ConnectionConfig)
+	if err != nil {
+		closeAll()
+		return nil, nil, err
+	}
+	closers = append(closers, func() { processingConn.Close() })
+
+	imagesService := service.NewImagesService(getImageServiceConfig(cfg), logger,
+		image_storage_service.NewImagesStorageServiceV1Client(storageConn),
+		image_processing_service.NewImageProcessingServiceV1Client(processingConn))
+	idempotencyRepo := repository.NewIdempotencyRepository(database, logger)
+	srv := service.NewMoviesPersonsService(logger, repo, imagesService, idempotencyRepo, idempotencyCfg,
+		service.ImportConfig{
+			PhotosDir:            cfg.ImportConfig.PhotosDir,
+			MaxPhotoSize:         cfg.ImportConfig.MaxPhotoSize,
+			PhotoDownloadTimeout: cfg.ImportConfig.PhotoDownloadTimeout,
+			AllowedHosts:         cfg.ImportConfig.PhotoAllowedHosts,
+		},
+		repository.NewPersonsChangesRepository(database, logger), watchCfg)
+	return serviceAPI{srv: srv}, closeAll, nil
+}
+
+func dial(addr string, connCfg config.ConnectionSecureConfig) (*grpc.ClientConn, error) {
+	creds, err := connCfg.GetGrpcTransportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Dial(addr, creds)
+}
+
+func getImageServiceConfig(cfg *config.Config) service.ImagesServiceConfig {
+	return service.ImagesServiceConfig{
+		ImageWidth:        cfg.ImageProcessingService.ProfilePictureWidth,
+		ImageHeight:       cfg.ImageProcessingService.ProfilePictureHeight,
+		ImageResizeMethod: service.ConvertResizeType(cfg.ImageProcessingService.ImageResizeMethod),
+		BasePhotoUrl:      cfg.ImageStorageService.BasePhotoUrl,
+		PicturesCategory:  cfg.ImageStorageService.PhotoCategory,
+		AllowedTypes:      cfg.ImageProcessingService.AllowedTypes,
+		MaxImageWidth:     cfg.ImageProcessingService.MaxImageWidth,
+		MaxImageHeight:    cfg.ImageProcessingService.MaxImageHeight,
+		MinImageWidth:     cfg.ImageProcessingService.MinImageWidth,
+		MinImageHeight:    cfg.ImageProcessingService.MinImageHeight,
+	}
+}
+
+// Calls the service directly, outgoing metadata is passed to the service as the incoming
+type serviceAPI struct {
+	srv movies_persons_service.MoviesPersonsServiceV1Server
+}
+
+func toIncomingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func (a serviceAPI) GetPersonsV2(ctx context.Context, in *movies_persons_service.GetPersonsRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.PersonsList, error) {
+	return a.srv.GetPersonsV2(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) GetPerson(ctx context.Context, in *movies_persons_service.GetPersonRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.PersonDetails, error) {
+	return a.srv.GetPerson(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) SearchPersonByNameV2(ctx context.Context, in *movies_persons_service.SearchPersonByNameRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.PersonsList, error) {
+	return a.srv.SearchPersonByNameV2(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) CreatePerson(ctx context.Context, in *movies_persons_service.CreatePersonRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.CreatePersonResponce, error) {
+	return a.srv.CreatePerson(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) BatchCreatePersons(ctx context.Context, in *movies_persons_service.BatchCreatePersonsRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.BatchCreatePersonsResponse, error) {
+	return a.srv.BatchCreatePersons(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) UpdatePersonFields(ctx context.Context, in *movies_persons_service.UpdatePersonFieldsRequest,
+	_ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return a.srv.UpdatePersonFields(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) DeletePersons(ctx context.Context, in *movies_persons_service.DeletePersonsRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.DeletePersonsResponce, error) {
+	return a.srv.DeletePersons(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) RestorePersons(ctx context.Context, in *movies_persons_service.RestorePersonsRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.RestorePersonsResponse, error) {
+	return a.srv.RestorePersons(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) FindPotentialDuplicates(ctx context.Context,
+	in *movies_persons_service.FindPotentialDuplicatesRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.PotentialDuplicates, error) {
+	return a.srv.FindPotentialDuplicates(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) ReemitPersonsEvents(ctx context.Context, in *movies_persons_service.ReemitPersonsEventsRequest,
+	_ ...grpc.CallOption) (*movies_persons_service.ReemitPersonsEventsResponse, error) {
+	return a.srv.ReemitPersonsEvents(toIncomingContext(ctx), in)
+}
+
+func (a serviceAPI) ImportPersons(ctx context.Context,
+	_ ...grpc.CallOption) (movies_persons_service.MoviesPersonsServiceV1_ImportPersonsClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &importPipe{
+		ctx:      ctx,
+		cancel:   cancel,
+		requests: make(chan *movies_persons_service.ImportPersonsRequest),
+		results:  make(chan *movies_persons_service.BatchCreatePersonResult),
+	}
+	go func() {
+		p.err = a.srv.ImportPersons(importPipeServer{p: p, ctx: toIncomingContext(ctx)})
+		close(p.results)
+		// unblocks the client, if it's still sending
+		cancel()
+	}()
+	return importPipeClient{p: p}, nil
+}
+
+// Connects the client and the server sides of the import stream in the same process
+type importPipe struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	requests chan *movies_persons_service.ImportPersonsRequest
+	results  chan *movies_persons_service.BatchCreatePersonResult
+	// set by the server before the results are closed
+	err error
+}
+
+// Only methods used by the commands are implemented, the others panic
+type importPipeClient struct {
+	grpc.ClientStream
+	p *importPipe
+}
+
+func (c importPipeClient) Context() context.Context {
+	return c.p.ctx
+}
+
+func (c importPipeClient) Send(in *movies_persons_service.ImportPersonsRequest) error {
+	select {
+	case c.p.requests <- in:
+		return nil
+	case <-c.p.ctx.Done():
+		return io.EOF
+	}
+}
+
+func (c importPipeClient) CloseSend() error {
+	close(c.p.requests)
+	return nil
+}
+
+func (c importPipeClient) Recv() (*movies_persons_service.BatchCreatePersonResult, error) {
+	res, ok := <-c.p.results
+	if ok {
+		return res, nil
+	}
+	if c.p.err != nil {
+		return nil, c.p.err
+	}
+	return nil, io.EOF
+}
+
+type importPipeServer struct {
+	grpc.ServerStream
+	p   *importPipe
+	ctx context.Context
+}
+
+func (s importPipeServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s importPipeServer) Send(res *movies_persons_service.BatchCreatePersonResult) error {
+	select {
+	case s.p.results <- res:
+		return nil
+	case <-s.p.ctx.Done():
+		return s.p.ctx.Err()
+	}
+}
+
+func (s importPipeServer) Recv() (*movies_persons_service.ImportPersonsRequest, error) {
+	select {
+	case in, ok := <-s.p.requests:
+		if !ok {
+			return nil, io.EOF
+		}
+		return in, nil
+	case <-s.p.ctx.Done():
+		return nil, s.p.ctx.Err()
+	}
+}
+
+func (a serviceAPI) ExportPersons(ctx context.Context, in *movies_persons_service.ExportPersonsRequest,
+	_ ...grpc.CallOption) (movies_persons_service.MoviesPersonsServiceV1_ExportPersonsClient, error) {
+	return newServerStreamPipe(ctx, func(stream serverStreamPipeServer[*movies_persons_service.ExportPersonsChunk]) error {
+		return a.srv.ExportPersons(in, exportChunksCopier{stream})
+	}), nil
+}
+
+// Copies the chunks before they are passed to the client, because data is reused by the service after the chunk is sent
+type exportChunksCopier struct {
+	serverStreamPipeServer[*movies_persons_service.ExportPersonsChunk]
+}
+
+func (c exportChunksCopier) Send(chunk *movies_persons_service.ExportPersonsChunk) error {
+	return c.serverStreamPipeServer.Send(&movies_persons_service.ExportPersonsChunk{Data: bytes.Clone(chunk.Data)})
+}
+
+func (a serviceAPI) WatchPersons(ctx context.Context, in *movies_persons_service.WatchPersonsRequest,
+	_ ...grpc.CallOption) (movies_persons_service.MoviesPersonsServiceV1_WatchPersonsClient, error) {
+	return newServerStreamPipe(ctx, func(stream serverStreamPipeServer[*movies_persons_service.PersonChange]) error {
+		return a.srv.WatchPersons(in, stream)
+	}), nil
+}
+
+// Passes the server stream messages to the client in the same process
+type serverStreamPipe[T any] struct {
+	ctx      context.Context
+	messages chan T
+	// set by the server before the messages are closed
+	err error
+}
+
+// Runs handle with the server side of the pipe in the new goroutine, returns the client side of the pipe
+func newServerStreamPipe[T any](ctx context.Context,
+	handle func(stream serverStreamPipeServer[T]) error) serverStreamPipeClient[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &serverStreamPipe[T]{ctx: ctx, messages: make(chan T)}
+	go func() {
+		p.err = handle(serverStreamPipeServer[T]{p: p, ctx: toIncomingContext(ctx)})
+		close(p.messages)
+		cancel()
+	}()
+	return serverStreamPipeClient[T]{p: p}
+}
+
+// Only methods used by the commands are implemented, the others panic
+type serverStreamPipeClient[T any] struct {
+	grpc.ClientStream
+	p *serverStreamPipe[T]
+}
+
+func (c serverStreamPipeClient[T]) Context() context.Context {
+	return c.p.ctx
+}
+
+func (c serverStreamPipeClient[T]) Recv() (T, error) {
+	msg, ok := <-c.p.messages
+	if ok {
+		return msg, nil
+	}
+	if c.p.err != nil {
+		return msg, c.p.err
+	}
+	return msg, io.EOF
+}
+
+type serverStreamPipeServer[T any] struct {
+	grpc.ServerStream
+	p   *serverStreamPipe[T]
+	ctx context.Context
+}
+
+func (s serverStreamPipeServer[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s serverStreamPipeServer[T]) Send(msg T) error {
+	select {
+	case s.p.messages <- msg:
+		return nil
+	case <-s.p.ctx.Done():
+		return s.p.ctx.Err()
+	}
+}
@@ -0,0 +1,866 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type command func(opts *globalOptions, args []string) error
+
+var commands = map[string]command{
+	"list":          listCommand,
+	"get":           getCommand,
+	"search":        searchCommand,
+	"create":        createCommand,
+	"update":        updateCommand,
+	"delete":        deleteCommand,
+	"restore":       restoreCommand,
+	"import":        importCommand,
+	"export":        exportCommand,
+	"duplicates":    duplicatesCommand,
+	"reemit-events": reemitEventsCommand,
+	"watch":         watchCommand,
+}
+
+const (
+	// Max page size of the list requests
+	maxPageSize = 100
+	// Size of the file chunks sent in the import stream
+	importChunkSize = 64 * 1024
+)
+
+func newFlagSet(name, argsUsage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: personsctl %s [flags] %s\n", name, argsUsage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+func listCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("list", "")
+	ids := fs.String("ids", "", "ids of the persons separated by ',', all persons are listed if empty")
+	limit := fs.Int("limit", 20, "persons per page, must be in range 10-100")
+	orderBy := fs.String("order-by", "", `order of the persons, for example "birthday desc"`)
+	pageToken := fs.String("page-token", "", "token of the page, printed after the previous page")
+	createdAfter := fs.String("created-after", "", "lists persons created at or after the time in RFC3339 format")
+	createdBefore := fs.String("created-before", "", "lists persons created before the time in RFC3339 format")
+	updatedAfter := fs.String("updated-after", "", "lists persons changed at or after the time in RFC3339 format")
+	updatedBefore := fs.String("updated-before", "", "lists persons changed before the time in RFC3339 format")
+	all := fs.Bool("all", false, "list all pages")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	in := &movies_persons_service.GetPersonsRequest{
+		PersonsIDs: *ids,
+		Limit:      int32(*limit),
+		Page:       1,
+		PageToken:  *pageToken,
+		OrderBy:    *orderBy,
+	}
+	var err error
+	for _, t := range []struct {
+		value  string
+		target **timestamppb.Timestamp
+	}{
+		{*createdAfter, &in.CreatedAfter},
+		{*createdBefore, &in.CreatedBefore},
+		{*updatedAfter, &in.UpdatedAfter},
+		{*updatedBefore, &in.UpdatedBefore},
+	} {
+		if *t.target, err = parseTime(t.value); err != nil {
+			return err
+		}
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	printer := newPersonsPrinter(opts.outputFmt)
+	defer printer.flush()
+	return forEachPage(opts, *all, func(pageToken string) (*movies_persons_service.PersonsList, error) {
+		ctx, cancel := opts.requestContext()
+		defer cancel()
+		in.PageToken = pageToken
+		return persons.GetPersonsV2(ctx, in)
+	}, in.PageToken, printer.printPerson)
+}
+
+func getCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("get", "<id>")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	id, err := strconv.ParseInt(fs.Arg(0), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid id %s", fs.Arg(0))
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	ctx, cancel := opts.requestContext()
+	defer cancel()
+	person, err := persons.GetPerson(ctx, &movies_persons_service.GetPersonRequest{ID: int32(id)})
+	if err != nil {
+		return err
+	}
+
+	printer := newPrinter(opts.outputFmt, "ID", "FULLNAME_RU", "FULLNAME_EN", "BIRTHDAY", "SEX", "PHOTO_ID",
+		"VERSION", "CREATED_AT", "CREATED_BY", "UPDATED_AT", "UPDATED_BY")
+	defer printer.flush()
+	return printer.print(person, person.ID, person.FullnameRU, person.FullnameEN, person.Birthday, person.Sex,
+		person.PhotoID, person.Version, formatTimestamp(person.CreatedAt), person.CreatedBy,
+		formatTimestamp(person.UpdatedAt), person.UpdatedBy)
+}
+
+func searchCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("search", "<name>")
+	fuzzy := fs.Bool("fuzzy", false, "search names with typos, persons are ordered by relevance")
+	limit := fs.Int("limit", 20, "persons per page, must be in range 10-100")
+	orderBy := fs.String("order-by", "", `order of the persons, for example "birthday desc"`)
+	all := fs.Bool("all", false, "list all pages")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	in := &movies_persons_service.SearchPersonByNameRequest{
+		Name:    fs.Arg(0),
+		Limit:   int32(*limit),
+		Page:    1,
+		OrderBy: *orderBy,
+	}
+	if *fuzzy {
+		in.Mode = movies_persons_service.SearchPersonByNameRequest_FUZZY
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	printer := newPersonsPrinter(opts.outputFmt)
+	defer printer.flush()
+	return forEachPage(opts, *all, func(pageToken string) (*movies_persons_service.PersonsList, error) {
+		ctx, cancel := opts.requestContext()
+		defer cancel()
+		in.PageToken = pageToken
+		return persons.SearchPersonByNameV2(ctx, in)
+	}, "", printer.printPerson)
+}
+
+func createCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("create", "")
+	fullnameRU := fs.String("fullname-ru", "", "russian full name, required")
+	fullnameEN := fs.String("fullname-en", "", "english full name")
+	birthday := fs.String("birthday", "", "birthday in format YYYY-MM-DD")
+	sex := fs.String("sex", "", "sex")
+	photo := fs.String("photo", "", "path to the photo file")
+	ignoreDuplicates := fs.Bool("ignore-potential-duplicates", false,
+		"create the person even if there are potential duplicates")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	in := &movies_persons_service.CreatePersonRequest{
+		FullnameRU:                *fullnameRU,
+		IgnorePotentialDuplicates: *ignoreDuplicates,
+	}
+	var err error
+	if in.Birthday, err = parseBirthday(*birthday); err != nil {
+		return err
+	}
+	if *fullnameEN != "" {
+		in.FullnameEN = fullnameEN
+	}
+	if *sex != "" {
+		in.Sex = sex
+	}
+	if *photo != "" {
+		if in.Photo, err = os.ReadFile(*photo); err != nil {
+			return err
+		}
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	ctx, cancel := opts.requestContext()
+	defer cancel()
+	res, err := persons.CreatePerson(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	printer := newPrinter(opts.outputFmt, "ID")
+	defer printer.flush()
+	return printer.print(res, res.PersonID)
+}
+
+func updateCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("update", "")
+	id := fs.Int("id", 0, "id of the person, required")
+	fullnameRU := fs.String("fullname-ru", "", "russian full name")
+	fullnameEN := fs.String("fullname-en", "", "english full name, empty value clears the name")
+	birthday := fs.String("birthday", "", "birthday in format YYYY-MM-DD, empty value clears the birthday")
+	sex := fs.String("sex", "", "sex, empty value clears the sex")
+	photo := fs.String("photo", "", "path to the photo file, empty value removes the photo")
+	expectedVersion := fs.Int("expected-version", 0, "if specified, person is updated only if it has this version")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	in := &movies_persons_service.UpdatePersonFieldsRequest{ID: int32(*id), UpdateMask: &fieldmaskpb.FieldMask{}}
+	var err error
+	// only explicitly specified flags are updated
+	fs.Visit(func(f *flag.Flag) {
+		if err != nil {
+			return
+		}
+		switch f.Name {
+		case "fullname-ru":
+			in.FullnameRU = fullnameRU
+		case "fullname-en":
+			in.FullnameEN = fullnameEN
+		case "birthday":
+			in.Birthday, err = parseBirthday(*birthday)
+		case "sex":
+			in.Sex = sex
+		case "photo":
+			if *photo != "" {
+				in.Photo, err = os.ReadFile(*photo)
+			}
+		case "expected-version":
+			version := int32(*expectedVersion)
+			in.ExpectedVersion = &version
+			return
+		default:
+			return
+		}
+		in.UpdateMask.Paths = append(in.UpdateMask.Paths, strings.ReplaceAll(f.Name, "-", "_"))
+	})
+	if err != nil {
+		return err
+	}
+	if len(in.UpdateMask.Paths) == 0 {
+		return fmt.Errorf("at least one of the person fields must be specified")
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	ctx, cancel := opts.requestContext()
+	defer cancel()
+	res, err := persons.UpdatePersonFields(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	printer := newPrinter(opts.outputFmt, "UPDATED_ID")
+	defer printer.flush()
+	return printer.print(res, in.ID)
+}
+
+func deleteCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("delete", "<id> [id...]")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ids, err := joinIDs(fs)
+	if err != nil {
+		return err
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	ctx, cancel := opts.requestContext()
+	defer cancel()
+	res, err := persons.DeletePersons(ctx, &movies_persons_service.DeletePersonsRequest{PersonsIDs: ids})
+	if err != nil {
+		return err
+	}
+	return printIDs(opts.outputFmt, "DELETED_ID", res, res.DeletedPersonIDs)
+}
+
+func restoreCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("restore", "<id> [id...]")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ids, err := joinIDs(fs)
+	if err != nil {
+		return err
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	ctx, cancel := opts.requestContext()
+	defer cancel()
+	res, err := persons.RestorePersons(ctx, &movies_persons_service.RestorePersonsRequest{PersonsIDs: ids})
+	if err != nil {
+		return err
+	}
+	return printIDs(opts.outputFmt, "RESTORED_ID", res, res.RestoredPersonsIDs)
+}
+
+func importCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("import", "")
+	file := fs.String("file", "-", "csv or jsonl file with the persons, - reads from stdin")
+	format := fs.String("format", "", "format of the file: csv or jsonl, detected by the file extension if empty")
+	dryRun := fs.Bool("dry-run", false, "only validate the persons and check them for duplicates")
+	ignoreDuplicates := fs.Bool("ignore-potential-duplicates", false,
+		"create the persons even if there are potential duplicates")
+	report := fs.String("report", "", "csv file for the result of each row")
+	fs.StringVar(&opts.photosDir, "photos-dir", "",
+		"directory, the photo paths are relative to, overrides the config, used only without -addr")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	importFormat, err := getImportFormat(*format, *file)
+	if err != nil {
+		return err
+	}
+	in, closeFile, err := openInput(*file)
+	if err != nil {
+		return err
+	}
+	defer closeFile()
+
+	var reportWriter *csv.Writer
+	if *report != "" {
+		out, closeReport, err := openOutput(*report)
+		if err != nil {
+			return err
+		}
+		defer closeReport()
+		reportWriter = csv.NewWriter(out)
+		reportWriter.Write([]string{"line", "status", "person_id", "existing_persons_ids", "error"})
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	// the whole file is imported in one stream, so the stream isn't limited by the timeout
+	ctx, cancel := context.WithCancel(opts.actorContext())
+	defer cancel()
+	stream, err := persons.ImportPersons(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the error is sent before the stream is canceled, so it's received, when Recv fails because of the cancel
+	readErrs := make(chan error, 1)
+	go func() {
+		err := sendImportFile(stream, in, &movies_persons_service.ImportPersonsRequest{
+			Format:                    importFormat,
+			DryRun:                    *dryRun,
+			IgnorePotentialDuplicates: *ignoreDuplicates,
+		})
+		if err != nil {
+			readErrs <- err
+			cancel()
+		}
+	}()
+
+	printer := newPrinter(opts.outputFmt, "LINE", "STATUS", "PERSON_ID", "ERROR")
+	defer printer.flush()
+	var succeeded, total int
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			select {
+			case readErr := <-readErrs:
+				return readErr
+			default:
+				return err
+			}
+		}
+
+		total++
+		if res.Status == movies_persons_service.BatchCreatePersonResult_CREATED ||
+			res.Status == movies_persons_service.BatchCreatePersonResult_VALID {
+			succeeded++
+		}
+		if err = printer.print(res, res.Index, res.Status, res.PersonID, res.Error); err != nil {
+			return err
+		}
+		if reportWriter != nil {
+			existing := make([]string, len(res.ExistingPersonsIDs))
+			for i, id := range res.ExistingPersonsIDs {
+				existing[i] = strconv.Itoa(int(id))
+			}
+			reportWriter.Write([]string{strconv.Itoa(int(res.Index)), res.Status.String(),
+				strconv.Itoa(int(res.PersonID)), strings.Join(existing, ","), res.Error})
+		}
+	}
+
+	if reportWriter != nil {
+		reportWriter.Flush()
+		if err = reportWriter.Error(); err != nil {
+			return err
+		}
+	}
+	if *dryRun {
+		fmt.Fprintf(os.Stderr, "dry run, %d of %d persons can be created\n", succeeded, total)
+	} else {
+		fmt.Fprintf(os.Stderr, "created %d of %d persons\n", succeeded, total)
+	}
+	return nil
+}
+
+func exportCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("export", "")
+	file := fs.String("file", "-", "file for the persons, - writes to stdout")
+	format := fs.String("format", "",
+		"format of the file: csv, jsonl or parquet, detected by the file extension if empty")
+	updatedSince := fs.String("updated-since", "", "exports persons changed at or after the time in RFC3339 format")
+	hasPhoto := fs.String("has-photo", "", "if true or false, exports only persons with or without photo")
+	sex := fs.String("sex", "", "exports only persons with the sex")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	in := &movies_persons_service.ExportPersonsRequest{}
+	var err error
+	if in.Format, err = getExportFormat(*format, *file); err != nil {
+		return err
+	}
+	if in.UpdatedSince, err = parseTime(*updatedSince); err != nil {
+		return err
+	}
+	if *hasPhoto != "" {
+		value, err := strconv.ParseBool(*hasPhoto)
+		if err != nil {
+			return fmt.Errorf("invalid has-photo %s, must be true or false", *hasPhoto)
+		}
+		in.HasPhoto = &value
+	}
+	if *sex != "" {
+		in.Sex = sex
+	}
+
+	out, closeFile, err := openOutput(*file)
+	if err != nil {
+		return err
+	}
+	defer closeFile()
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	// the whole file is exported in one stream, so the stream isn't limited by the timeout
+	ctx, cancel := context.WithCancel(opts.actorContext())
+	defer cancel()
+	stream, err := persons.ExportPersons(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	var size int
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if _, err = out.Write(chunk.Data); err != nil {
+			return err
+		}
+		size += len(chunk.Data)
+	}
+
+	fmt.Fprintf(os.Stderr, "exported %d bytes\n", size)
+	return nil
+}
+
+func watchCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("watch", "")
+	since := fs.Int64("since", -1, "prints changes after the revision first, if negative, only new changes are printed")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	in := &movies_persons_service.WatchPersonsRequest{}
+	if *since >= 0 {
+		in.SinceRevision = since
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	// changes are watched until the interrupt
+	ctx, cancel := signal.NotifyContext(opts.actorContext(), os.Interrupt)
+	defer cancel()
+	stream, err := persons.WatchPersons(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	printer := newPrinter(opts.outputFmt, "REVISION", "TYPE", "CHANGED_AT", "ID", "FULLNAME_RU", "FULLNAME_EN",
+		"BIRTHDAY", "SEX", "VERSION", "UPDATED_BY")
+	defer printer.flush()
+	for {
+		change, err := stream.Recv()
+		if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		person := change.GetPerson()
+		err = printer.print(change, change.Revision, change.Type, formatTimestamp(change.ChangedAt), person.GetID(),
+			person.GetFullnameRU(), person.GetFullnameEN(), person.GetBirthday(), person.GetSex(),
+			person.GetVersion(), person.GetUpdatedBy())
+		if err != nil {
+			return err
+		}
+		// changes are printed as they are received
+		printer.flush()
+	}
+}
+
+func duplicatesCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("duplicates", "")
+	minScore := fs.Float64("min-score", 0.8, "min score of the potential duplicates in range [0;1]")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	score := float32(*minScore)
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	type duplicatesPair struct {
+		PersonID    int32   `json:"person_id"`
+		DuplicateID int32   `json:"duplicate_id"`
+		Score       float32 `json:"score"`
+		NameScore   float32 `json:"name_score"`
+	}
+	printer := newPrinter(opts.outputFmt, "PERSON_ID", "DUPLICATE_ID", "SCORE", "FULLNAME_RU", "DUPLICATE_FULLNAME_RU")
+	defer printer.flush()
+
+	in := &movies_persons_service.GetPersonsRequest{Limit: maxPageSize, Page: 1}
+	return forEachPage(opts, true, func(pageToken string) (*movies_persons_service.PersonsList, error) {
+		ctx, cancel := opts.requestContext()
+		defer cancel()
+		in.PageToken = pageToken
+		return persons.GetPersonsV2(ctx, in)
+	}, "", func(p *movies_persons_service.Person) error {
+		ctx, cancel := opts.requestContext()
+		defer cancel()
+		res, err := persons.FindPotentialDuplicates(ctx, &movies_persons_service.FindPotentialDuplicatesRequest{
+			PersonID: p.ID,
+			MinScore: &score,
+		})
+		if err != nil {
+			return fmt.Errorf("error while searching duplicates of the person %d: %w", p.ID, err)
+		}
+
+		for _, d := range res.Duplicates {
+			// each pair is printed once
+			if d.Person.GetID() <= p.ID {
+				continue
+			}
+			err = printer.print(duplicatesPair{
+				PersonID:    p.ID,
+				DuplicateID: d.Person.GetID(),
+				Score:       d.Score,
+				NameScore:   d.NameScore,
+			}, p.ID, d.Person.GetID(), d.Score, p.FullnameRU, d.Person.GetFullnameRU())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func reemitEventsCommand(opts *globalOptions, args []string) error {
+	fs := newFlagSet("reemit-events", "[id...]")
+	all := fs.Bool("all", false, "send events for all not deleted persons")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *all == (fs.NArg() > 0) {
+		return fmt.Errorf("either ids or -all must be specified")
+	}
+
+	persons, closeBackend, err := opts.connect()
+	if err != nil {
+		return err
+	}
+	defer closeBackend()
+
+	printer := newPrinter(opts.outputFmt, "REEMITTED_ID")
+	defer printer.flush()
+	reemit := func(ids string) error {
+		ctx, cancel := opts.requestContext()
+		defer cancel()
+		res, err := persons.ReemitPersonsEvents(ctx, &movies_persons_service.ReemitPersonsEventsRequest{PersonsIDs: ids})
+		if err != nil {
+			return err
+		}
+		return printIDsWith(printer, res, res.ReemittedPersonsIDs)
+	}
+
+	if !*all {
+		ids, err := joinIDs(fs)
+		if err != nil {
+			return err
+		}
+		return reemit(ids)
+	}
+
+	in := &movies_persons_service.GetPersonsRequest{Limit: maxPageSize, Page: 1}
+	for pageToken := ""; ; {
+		ctx, cancel := opts.requestContext()
+		in.PageToken = pageToken
+		list, err := persons.GetPersonsV2(ctx, in)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if len(list.Persons) > 0 {
+			ids := make([]string, len(list.Persons))
+			for i, p := range list.Persons {
+				ids[i] = strconv.Itoa(int(p.ID))
+			}
+			if err = reemit(strings.Join(ids, ",")); err != nil {
+				return err
+			}
+		}
+		if pageToken = list.NextPageToken; pageToken == "" {
+			return nil
+		}
+	}
+}
+
+// Calls handle for each person on the pages, starting from the page with the pageToken.
+// If all is false, only one page is handled and the next page token is printed to stderr
+func forEachPage(opts *globalOptions, all bool,
+	fetch func(pageToken string) (*movies_persons_service.PersonsList, error),
+	pageToken string, handle func(*movies_persons_service.Person) error) error {
+	for {
+		list, err := fetch(pageToken)
+		if err != nil {
+			return err
+		}
+		for _, p := range list.Persons {
+			if err = handle(p); err != nil {
+				return err
+			}
+		}
+
+		pageToken = list.NextPageToken
+		if pageToken == "" {
+			return nil
+		} else if !all {
+			fmt.Fprintf(os.Stderr, "total %d persons, next page token: %s\n", list.TotalCount, pageToken)
+			return nil
+		}
+	}
+}
+
+// Sends options with the first chunk of the file, then the rest of the file
+func sendImportFile(stream movies_persons_service.MoviesPersonsServiceV1_ImportPersonsClient,
+	in io.Reader, first *movies_persons_service.ImportPersonsRequest) error {
+	req := first
+	for {
+		// chunk can be still used by the service, that works in the same process, so it isn't reused
+		buf := make([]byte, importChunkSize)
+		n, err := io.ReadFull(in, buf)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			if n > 0 || req == first {
+				req.Chunk = buf[:n]
+				// io.EOF means, that the stream is closed by the server, the error is returned by Recv
+				if err = stream.Send(req); err != nil && !errors.Is(err, io.EOF) {
+					return err
+				}
+			}
+			return stream.CloseSend()
+		} else if err != nil {
+			return err
+		}
+
+		req.Chunk = buf[:n]
+		if err = stream.Send(req); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		req = &movies_persons_service.ImportPersonsRequest{}
+	}
+}
+
+func getImportFormat(format, file string) (movies_persons_service.ImportPersonsRequest_Format, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+	switch strings.ToLower(format) {
+	case "csv":
+		return movies_persons_service.ImportPersonsRequest_CSV, nil
+	case "jsonl", "":
+		return movies_persons_service.ImportPersonsRequest_JSONL, nil
+	default:
+		return 0, fmt.Errorf("unknown format %s, supported formats: csv, jsonl", format)
+	}
+}
+
+func getExportFormat(format, file string) (movies_persons_service.ExportPersonsRequest_Format, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+	switch strings.ToLower(format) {
+	case "csv":
+		return movies_persons_service.ExportPersonsRequest_CSV, nil
+	case "jsonl", "":
+		return movies_persons_service.ExportPersonsRequest_JSONL, nil
+	case "parquet":
+		return movies_persons_service.ExportPersonsRequest_PARQUET, nil
+	default:
+		return 0, fmt.Errorf("unknown format %s, supported formats: csv, jsonl, parquet", format)
+	}
+}
+
+// Returns nil if birthday is empty
+func parseBirthday(birthday string) (*timestamppb.Timestamp, error) {
+	if birthday == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.DateOnly, birthday)
+	if err != nil {
+		return nil, fmt.Errorf("invalid birthday %s, must be in format YYYY-MM-DD", birthday)
+	}
+	return timestamppb.New(t), nil
+}
+
+// Returns nil if t is empty
+func parseTime(t string) (*timestamppb.Timestamp, error) {
+	if t == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %s, must be in RFC3339 format", t)
+	}
+	return timestamppb.New(parsed), nil
+}
+
+// Returns ids from the args separated by ','
+func joinIDs(fs *flag.FlagSet) (string, error) {
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return "", flag.ErrHelp
+	}
+	for _, id := range fs.Args() {
+		if _, err := strconv.ParseInt(id, 10, 32); err != nil {
+			return "", fmt.Errorf("invalid id %s", id)
+		}
+	}
+	return strings.Join(fs.Args(), ","), nil
+}
+
+func printIDs(format outputFormat, header string, res any, ids []int32) error {
+	printer := newPrinter(format, header)
+	defer printer.flush()
+	return printIDsWith(printer, res, ids)
+}
+
+// Prints each id as the table row or res as json
+func printIDsWith(printer *printer, res any, ids []int32) error {
+	if printer.format == jsonOutput {
+		return printer.print(res)
+	}
+	for _, id := range ids {
+		if err := printer.print(nil, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func openInput(file string) (io.Reader, func(), error) {
+	if file == "-" {
+		return os.Stdin, func() {}, nil
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() { f.Close() }, nil
+}
+
+func openOutput(file string) (io.Writer, func(), error) {
+	if file == "-" {
+		return os.Stdout, func() {}, nil
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() { f.Close() }, nil
+}
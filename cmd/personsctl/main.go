@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/config"
+	"github.com/Falokut/admin_movies_persons_service/internal/service"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `personsctl manages persons directly in the database or through the running service.
+
+usage: personsctl [global flags] <command> [command flags] [args]
+
+commands:
+  list            lists persons page by page
+  get <id>        prints the person
+  search <name>   searches persons by name
+  create          creates the person
+  update          updates fields of the person
+  delete <ids>    marks persons as deleted
+  restore <ids>   restores deleted persons
+  import          creates persons from the csv or jsonl file
+  export          writes persons into the csv, jsonl or parquet file
+  duplicates      scans all persons for the potential duplicates
+  reemit-events   sends person_updated events with the current state of the persons
+  watch           prints changes of the persons as they are made
+
+use personsctl <command> -h for the command flags
+
+global flags:`
+
+type globalOptions struct {
+	addr      string
+	secure    bool
+	config    string
+	output    string
+	actor     string
+	timeout   time.Duration
+	logLevel  string
+	outputFmt outputFormat
+	// set by the import command, overrides the photos dir from the config
+	photosDir string
+}
+
+func main() {
+	var opts globalOptions
+	flag.StringVar(&opts.addr, "addr", "",
+		"address of the running service, if empty, persons are changed directly in the database")
+	flag.BoolVar(&opts.secure, "tls", false, "use tls with the system cert pool to connect to the service")
+	flag.StringVar(&opts.config, "config", "configs/config.yml",
+		"service config, used to connect to the database and the images services, if addr isn't specified")
+	flag.StringVar(&opts.output, "output", string(tableOutput), "output format: table or json")
+	flag.StringVar(&opts.actor, "actor", "", "account id, that is stored as the author of the changes")
+	flag.DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout of each request, imports and exports aren't limited")
+	flag.StringVar(&opts.logLevel, "log-level", "error", "log level of the database backend")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	err := run(&opts, flag.Arg(0), flag.Args()[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts *globalOptions, command string, args []string) error {
+	cmd, ok := commands[command]
+	if !ok {
+		return fmt.Errorf("unknown command %s, run personsctl -h to list commands", command)
+	}
+
+	format, err := parseOutputFormat(opts.output)
+	if err != nil {
+		return err
+	}
+	opts.outputFmt = format
+	return cmd(opts, args)
+}
+
+// Connects to the service or to the database, called by the commands after their flags are parsed,
+// returned func closes the connections
+func (o *globalOptions) connect() (personsAPI, func(), error) {
+	if o.addr != "" {
+		return newGRPCBackend(o.addr, o.secure)
+	}
+
+	cfg, err := config.ReadConfig(o.config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while reading config %s: %w", o.config, err)
+	}
+	level, err := logrus.ParseLevel(o.logLevel)
+	if err != nil {
+		return nil, nil, err
+	}
+	if o.photosDir != "" {
+		cfg.ImportConfig.PhotosDir = o.photosDir
+	}
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	logger.SetLevel(level)
+	return newDBBackend(cfg, logger)
+}
+
+// Returns context for the request with the timeout and the actor in the metadata
+func (o *globalOptions) requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(o.actorContext(), o.timeout)
+}
+
+// Returns context with the actor in the metadata
+func (o *globalOptions) actorContext() context.Context {
+	ctx := context.Background()
+	if o.actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, service.ActorMetadataKey, o.actor)
+	}
+	return ctx
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type outputFormat string
+
+const (
+	tableOutput outputFormat = "table"
+	jsonOutput  outputFormat = "json"
+)
+
+func parseOutputFormat(format string) (outputFormat, error) {
+	switch outputFormat(strings.ToLower(format)) {
+	case tableOutput:
+		return tableOutput, nil
+	case jsonOutput:
+		return jsonOutput, nil
+	default:
+		return "", fmt.Errorf("unknown output format %s, supported formats: %s, %s", format, tableOutput, jsonOutput)
+	}
+}
+
+// Prints rows as the table or values as the json lines
+type printer struct {
+	format outputFormat
+	out    io.Writer
+	table  *tabwriter.Writer
+}
+
+func newPrinter(format outputFormat, header ...string) *printer {
+	p := &printer{format: format, out: os.Stdout}
+	if format == tableOutput {
+		p.table = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(p.table, strings.Join(header, "\t"))
+	}
+	return p
+}
+
+// Prints the row in the table format or the value in the json format,
+// proto messages are encoded with the json names from the proto files
+func (p *printer) print(value any, row ...any) error {
+	if p.format == tableOutput {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = fmt.Sprint(cell)
+		}
+		_, err := fmt.Fprintln(p.table, strings.Join(cells, "\t"))
+		return err
+	}
+
+	var body []byte
+	var err error
+	if msg, ok := value.(proto.Message); ok {
+		body, err = protojson.Marshal(msg)
+	} else {
+		body, err = json.Marshal(value)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.out, string(body))
+	return err
+}
+
+func (p *printer) flush() {
+	if p.table != nil {
+		p.table.Flush()
+	}
+}
+
+var personsHeader = []string{"ID", "FULLNAME_RU", "FULLNAME_EN", "BIRTHDAY", "SEX", "VERSION", "UPDATED_AT", "UPDATED_BY"}
+
+func newPersonsPrinter(format outputFormat) *printer {
+	return newPrinter(format, personsHeader...)
+}
+
+func (p *printer) printPerson(person *movies_persons_service.Person) error {
+	return p.print(person, person.ID, person.FullnameRU, person.FullnameEN, person.Birthday, person.Sex,
+		person.Version, formatTimestamp(person.UpdatedAt), person.UpdatedBy)
+}
+
+func formatTimestamp(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().Format(time.RFC3339)
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 
 	"github.com/Falokut/admin_movies_persons_service/internal/config"
@@ -38,6 +37,13 @@ func main() {
 	}
 	logger.Logger.SetLevel(logLevel)
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrateCommand(cfg, logger.Logger, os.Args[2:]); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+
 	tracer, closer, err := jaegerTracer.InitJaeger(cfg.JaegerConfig)
 	if err != nil {
 		logger.Errorf("Shutting down, error while creating tracer %v", err)
@@ -70,6 +76,12 @@ func main() {
 		return
 	}
 
+	logger.Info("Migrations checking")
+	if err = migrateOnStartup(cfg, logger.Logger, database); err != nil {
+		logger.Errorf("Shutting down, error while migrating the database: %s", err.Error())
+		return
+	}
+
 	logger.Info("Repository initializing")
 	repo := repository.NewPersonsRepository(database, logger.Logger)
 	defer repo.Shutdown()
@@ -103,11 +115,78 @@ func main() {
 	imagesService := service.NewImagesService(getImageServiceConfig(cfg),
 		logger.Logger, imageStorageService, imageProcessingService)
 
-	personsEvents := events.NewPersonsEvents(events.KafkaConfig{Brokers: cfg.KafkaConfig.Brokers}, logger.Logger)
+	personsEvents := events.NewPersonsEvents(events.KafkaConfig{
+		Brokers:      cfg.KafkaConfig.Brokers,
+		EventsFormat: cfg.KafkaConfig.EventsFormat,
+		SchemaID:     cfg.KafkaConfig.SchemaID,
+	}, logger.Logger)
 	defer personsEvents.Shutdown()
 
+	logger.Info("Events relay initializing")
+	eventsRelayCfg := getEventsRelayConfig(cfg)
+	if err = eventsRelayCfg.Validate(); err != nil {
+		logger.Errorf("Shutting down, invalid events_relay config: %s", err.Error())
+		return
+	}
+	outboxRepo := repository.NewOutboxRepository(database, logger.Logger)
+	eventsRelay := service.NewEventsRelay(eventsRelayCfg, logger.Logger, outboxRepo, personsEvents)
+	bgCtx, stopBackgroundJobs := context.WithCancel(context.Background())
+	defer stopBackgroundJobs()
+	go func() {
+		logger.Info("Events relay running")
+		eventsRelay.Run(bgCtx)
+	}()
+
+	logger.Info("Deleted persons purger initializing")
+	purgerCfg := getDeletedPersonsPurgerConfig(cfg)
+	if err = purgerCfg.Validate(); err != nil {
+		logger.Errorf("Shutting down, invalid deleted_persons_purger config: %s", err.Error())
+		return
+	}
+	purger := service.NewDeletedPersonsPurger(purgerCfg, logger.Logger, repo)
+	go func() {
+		logger.Info("Deleted persons purger running")
+		purger.Run(bgCtx)
+	}()
+
+	logger.Info("Idempotency keys cleaner initializing")
+	idempotencyCfg, idempotencyKeysCleanerCfg := getIdempotencyConfig(cfg), getIdempotencyKeysCleanerConfig(cfg)
+	if err = idempotencyCfg.Validate(); err == nil {
+		err = idempotencyKeysCleanerCfg.Validate()
+	}
+	if err != nil {
+		logger.Errorf("Shutting down, invalid idempotency config: %s", err.Error())
+		return
+	}
+	idempotencyRepo := repository.NewIdempotencyRepository(database, logger.Logger)
+	idempotencyKeysCleaner := service.NewIdempotencyKeysCleaner(idempotencyKeysCleanerCfg,
+		logger.Logger, idempotencyRepo)
+	go func() {
+		logger.Info("Idempotency keys cleaner running")
+		idempotencyKeysCleaner.Run(bgCtx)
+	}()
+
+	logger.Info("Persons changes cleaner initializing")
+	changesCleanerCfg := getPersonsChangesCleanerConfig(cfg)
+	if err = changesCleanerCfg.Validate(); err != nil {
+		logger.Errorf("Shutting down, invalid watch config: %s", err.Error())
+		return
+	}
+	changesRepo := repository.NewPersonsChangesRepository(database, logger.Logger)
+	changesCleaner := service.NewPersonsChangesCleaner(changesCleanerCfg, logger.Logger, changesRepo)
+	go func() {
+		logger.Info("Persons changes cleaner running")
+		changesCleaner.Run(bgCtx)
+	}()
+
 	logger.Info("Service initializing")
-	service := service.NewMoviesPersonsService(logger.Logger, repo, imagesService, personsEvents)
+	watchCfg := getWatchConfig(cfg)
+	if err = watchCfg.Validate(); err != nil {
+		logger.Errorf("Shutting down, invalid watch config: %s", err.Error())
+		return
+	}
+	service := service.NewMoviesPersonsService(logger.Logger, repo, imagesService,
+		idempotencyRepo, idempotencyCfg, getImportConfig(cfg), changesRepo, watchCfg)
 
 	logger.Info("Server initializing")
 	s := server.NewServer(logger.Logger, service)
@@ -127,22 +206,36 @@ func main() {
 	case <-shutdown:
 		break
 	}
+	stopBackgroundJobs()
 	s.Shutdown()
 }
 
 func getListenServerConfig(cfg *config.Config) server.Config {
 	return server.Config{
-		Mode:        cfg.Listen.Mode,
-		Host:        cfg.Listen.Host,
-		Port:        cfg.Listen.Port,
-		ServiceDesc: &movies_persons_service.MoviesPersonsServiceV1_ServiceDesc,
+		Mode:                   cfg.Listen.Mode,
+		Host:                   cfg.Listen.Host,
+		Port:                   cfg.Listen.Port,
+		AllowedHeaders:         cfg.Listen.AllowedHeaders,
+		AllowedOutgoingHeaders: cfg.Listen.AllowedOutgoingHeaders,
+		ServiceDesc:            &movies_persons_service.MoviesPersonsServiceV1_ServiceDesc,
 		RegisterRestHandlerServer: func(ctx context.Context, mux *runtime.ServeMux, service any) error {
 			serv, ok := service.(movies_persons_service.MoviesPersonsServiceV1Server)
 			if !ok {
 				return errors.New(" can't convert")
 			}
-			return movies_persons_service.RegisterMoviesPersonsServiceV1HandlerServer(context.Background(),
+			err := movies_persons_service.RegisterMoviesPersonsServiceV1HandlerServer(context.Background(),
 				mux, serv)
+			if err != nil {
+				return err
+			}
+			exporter, ok := service.(personsExporter)
+			if !ok {
+				return errors.New(" can't convert")
+			}
+			if err = registerExportPersonsHandler(mux, exporter); err != nil {
+				return err
+			}
+			return registerWatchPersonsHandler(mux, serv)
 		},
 	}
 }
@@ -175,7 +268,7 @@ func getImageServiceConfig(cfg *config.Config) service.ImagesServiceConfig {
 	return service.ImagesServiceConfig{
 		ImageWidth:        cfg.ImageProcessingService.ProfilePictureWidth,
 		ImageHeight:       cfg.ImageProcessingService.ProfilePictureHeight,
-		ImageResizeMethod: ConvertResizeType(cfg.ImageProcessingService.ImageResizeMethod),
+		ImageResizeMethod: service.ConvertResizeType(cfg.ImageProcessingService.ImageResizeMethod),
 		BasePhotoUrl:      cfg.ImageStorageService.BasePhotoUrl,
 		PicturesCategory:  cfg.ImageStorageService.PhotoCategory,
 		AllowedTypes:      cfg.ImageProcessingService.AllowedTypes,
@@ -186,20 +279,58 @@ func getImageServiceConfig(cfg *config.Config) service.ImagesServiceConfig {
 	}
 }
 
-func ConvertResizeType(resizeType string) image_processing_service.ResampleFilter {
-	resizeType = strings.ToTitle(resizeType)
-	switch resizeType {
-	case "Box":
-		return image_processing_service.ResampleFilter_Box
-	case "CatmullRom":
-		return image_processing_service.ResampleFilter_CatmullRom
-	case "Lanczos":
-		return image_processing_service.ResampleFilter_Lanczos
-	case "Linear":
-		return image_processing_service.ResampleFilter_Linear
-	case "MitchellNetravali":
-		return image_processing_service.ResampleFilter_MitchellNetravali
-	default:
-		return image_processing_service.ResampleFilter_NearestNeighbor
+func getEventsRelayConfig(cfg *config.Config) service.EventsRelayConfig {
+	return service.EventsRelayConfig{
+		PollInterval: cfg.EventsRelayConfig.PollInterval,
+		BatchSize:    cfg.EventsRelayConfig.BatchSize,
+		MaxBackoff:   cfg.EventsRelayConfig.MaxBackoff,
+		MaxAttempts:  cfg.EventsRelayConfig.MaxAttempts,
+	}
+}
+
+func getDeletedPersonsPurgerConfig(cfg *config.Config) service.DeletedPersonsPurgerConfig {
+	return service.DeletedPersonsPurgerConfig{
+		RetentionPeriod: cfg.DeletedPersonsPurgerConfig.RetentionPeriod,
+		Interval:        cfg.DeletedPersonsPurgerConfig.Interval,
+		BatchSize:       cfg.DeletedPersonsPurgerConfig.BatchSize,
+	}
+}
+
+func getIdempotencyConfig(cfg *config.Config) service.IdempotencyConfig {
+	return service.IdempotencyConfig{
+		KeyTTL:         cfg.IdempotencyConfig.KeyTTL,
+		PendingTimeout: cfg.IdempotencyConfig.PendingTimeout,
+	}
+}
+
+func getImportConfig(cfg *config.Config) service.ImportConfig {
+	return service.ImportConfig{
+		PhotosDir:            cfg.ImportConfig.PhotosDir,
+		MaxPhotoSize:         cfg.ImportConfig.MaxPhotoSize,
+		PhotoDownloadTimeout: cfg.ImportConfig.PhotoDownloadTimeout,
+		AllowedHosts:         cfg.ImportConfig.PhotoAllowedHosts,
+	}
+}
+
+func getIdempotencyKeysCleanerConfig(cfg *config.Config) service.IdempotencyKeysCleanerConfig {
+	return service.IdempotencyKeysCleanerConfig{
+		KeyTTL:    cfg.IdempotencyConfig.KeyTTL,
+		Interval:  cfg.IdempotencyConfig.CleanupInterval,
+		BatchSize: cfg.IdempotencyConfig.CleanupBatchSize,
+	}
+}
+
+func getWatchConfig(cfg *config.Config) service.WatchConfig {
+	return service.WatchConfig{
+		PollInterval: cfg.WatchConfig.PollInterval,
+		BatchSize:    cfg.WatchConfig.BatchSize,
+	}
+}
+
+func getPersonsChangesCleanerConfig(cfg *config.Config) service.PersonsChangesCleanerConfig {
+	return service.PersonsChangesCleanerConfig{
+		RetentionPeriod: cfg.WatchConfig.ChangesRetentionPeriod,
+		Interval:        cfg.WatchConfig.CleanupInterval,
+		BatchSize:       cfg.WatchConfig.CleanupBatchSize,
 	}
 }
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/service"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const exportPersonsPath = "/v1/persons/export"
+
+type personsExporter interface {
+	WritePersonsExport(ctx context.Context, in *movies_persons_service.ExportPersonsRequest, w io.Writer) error
+}
+
+// Registers the REST download of the ExportPersons file, must be registered after the generated handlers,
+// because streaming isn't supported by the generated in-process handlers
+func registerExportPersonsHandler(mux *runtime.ServeMux, exporter personsExporter) error {
+	return mux.HandlePath(http.MethodGet, exportPersonsPath,
+		func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+			_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+
+			in := &movies_persons_service.ExportPersonsRequest{}
+			filter := &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+			if err := runtime.PopulateQueryParameters(in, req.URL.Query(), filter); err != nil {
+				runtime.HTTPError(req.Context(), mux, outboundMarshaler, w, req,
+					status.Errorf(codes.InvalidArgument, "%v", err))
+				return
+			}
+
+			contentType, extension := service.GetExportFormatInfo(in.Format)
+			writer := &exportResponseWriter{w: w, contentType: contentType,
+				filename: "persons." + extension}
+			if err := exporter.WritePersonsExport(req.Context(), in, writer); err != nil {
+				// after the file is started, the status can't be changed, the response is cut off
+				if !writer.started {
+					runtime.HTTPError(req.Context(), mux, outboundMarshaler, w, req, err)
+				}
+			}
+		})
+}
+
+// Writes headers before the first part of the file
+type exportResponseWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.w.Header().Set("Content-Type", e.contentType)
+		e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.filename))
+		e.w.WriteHeader(http.StatusOK)
+	}
+	n, err := e.w.Write(p)
+	if f, ok := e.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return n, err
+}
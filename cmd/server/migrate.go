@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/config"
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	migrateOnStartupUp     = "UP"
+	migrateOnStartupVerify = "VERIFY"
+	migrateOnStartupSkip   = "SKIP"
+)
+
+const migrateUsage = `usage: app migrate <command>
+commands:
+  status          prints applied and not applied migrations
+  up              applies all not applied migrations
+  down [steps]    reverts the last steps migrations, default 1
+  to <version>    applies or reverts migrations up to the version`
+
+// Runs the migrate subcommand, args are the arguments after the subcommand name
+func runMigrateCommand(cfg *config.Config, logger *logrus.Logger, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	database, err := repository.NewPostgreDB(getMigrationsDBConfig(cfg))
+	if err != nil {
+		return fmt.Errorf("connection to the database is not established: %w", err)
+	}
+	defer database.Close()
+
+	migrator, err := repository.NewMigrator(database, logger)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printMigrationsStatus(statuses)
+		return nil
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil {
+				return fmt.Errorf("invalid steps %s\n%s", args[1], migrateUsage)
+			}
+		}
+		return migrator.Down(ctx, steps)
+	case "to":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %s\n%s", args[1], migrateUsage)
+		}
+		return migrator.To(ctx, version)
+	default:
+		return fmt.Errorf("unknown command %s\n%s", args[0], migrateUsage)
+	}
+}
+
+// Applies or verifies migrations before the service start, depending on the migrations on_startup mode
+func migrateOnStartup(cfg *config.Config, logger *logrus.Logger, database *sqlx.DB) error {
+	ctx := context.Background()
+	switch strings.ToUpper(cfg.MigrationsConfig.OnStartup) {
+	case migrateOnStartupUp:
+		migrationsDB := database
+		if cfg.MigrationsConfig.Username != "" {
+			var err error
+			migrationsDB, err = repository.NewPostgreDB(getMigrationsDBConfig(cfg))
+			if err != nil {
+				return fmt.Errorf("connection to the database for migrations is not established: %w", err)
+			}
+			defer migrationsDB.Close()
+		}
+
+		migrator, err := repository.NewMigrator(migrationsDB, logger)
+		if err != nil {
+			return err
+		}
+		return migrator.Up(ctx)
+	case migrateOnStartupVerify:
+		migrator, err := repository.NewMigrator(database, logger)
+		if err != nil {
+			return err
+		}
+		return migrator.Verify(ctx)
+	case migrateOnStartupSkip:
+		return nil
+	default:
+		return fmt.Errorf("unknown migrations on_startup mode %s, supported modes: %s, %s, %s",
+			cfg.MigrationsConfig.OnStartup, migrateOnStartupUp, migrateOnStartupVerify, migrateOnStartupSkip)
+	}
+}
+
+func getMigrationsDBConfig(cfg *config.Config) repository.DBConfig {
+	dbConfig := cfg.DBConfig
+	if cfg.MigrationsConfig.Username != "" {
+		dbConfig.Username = cfg.MigrationsConfig.Username
+		dbConfig.Password = cfg.MigrationsConfig.Password
+	}
+	return dbConfig
+}
+
+func printMigrationsStatus(statuses []repository.MigrationStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, status := range statuses {
+		state, appliedAt := "pending", ""
+		if status.Applied {
+			state, appliedAt = "applied", status.AppliedAt.Format(time.RFC3339)
+		}
+		if status.Unknown {
+			state = "applied, unknown"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	w.Flush()
+}
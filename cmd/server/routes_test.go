@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// Records the called methods and their arguments
+type routesTestServer struct {
+	movies_persons_service.UnimplementedMoviesPersonsServiceV1Server
+	called string
+	id     int32
+	name   string
+}
+
+func (s *routesTestServer) GetPerson(ctx context.Context,
+	in *movies_persons_service.GetPersonRequest) (*movies_persons_service.PersonDetails, error) {
+	s.called, s.id = "GetPerson", in.ID
+	return &movies_persons_service.PersonDetails{ID: in.ID}, nil
+}
+
+func (s *routesTestServer) IsPersonExists(ctx context.Context,
+	in *movies_persons_service.IsPersonExistsRequest) (*movies_persons_service.IsPersonExistsResponse, error) {
+	s.called, s.name = "IsPersonExists", in.GetFullnameRU()
+	return &movies_persons_service.IsPersonExistsResponse{}, nil
+}
+
+func (s *routesTestServer) IsPersonWithIDExists(ctx context.Context,
+	in *movies_persons_service.IsPersonWithIDExistsRequest) (*movies_persons_service.IsPersonWithIDExistsResponse, error) {
+	s.called, s.id = "IsPersonWithIDExists", in.PersonID
+	return &movies_persons_service.IsPersonWithIDExistsResponse{}, nil
+}
+
+func TestPersonRoutes(t *testing.T) {
+	tests := []struct {
+		url    string
+		called string
+		id     int32
+		name   string
+	}{
+		{"/v1/person/exists?fullname_ru=Андрей", "IsPersonExists", 0, "Андрей"},
+		{"/v1/person/10", "GetPerson", 10, ""},
+		{"/v1/person/10/exists", "IsPersonWithIDExists", 10, ""},
+	}
+
+	for _, tt := range tests {
+		srv := &routesTestServer{}
+		mux := runtime.NewServeMux()
+		if err := movies_persons_service.RegisterMoviesPersonsServiceV1HandlerServer(context.Background(),
+			mux, srv); err != nil {
+			t.Fatalf("register handlers: %v", err)
+		}
+
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("GET %s: status %d, body %s", tt.url, rec.Code, rec.Body)
+			continue
+		}
+		if srv.called != tt.called || srv.id != tt.id || srv.name != tt.name {
+			t.Errorf("GET %s: called %s(%d, %q), want %s(%d, %q)",
+				tt.url, srv.called, srv.id, srv.name, tt.called, tt.id, tt.name)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	watchPersonsPath = "/v1/persons/watch"
+	// Interval of the comments sent to keep the idle connection open
+	sseKeepAliveInterval = 15 * time.Second
+)
+
+type personsWatcher interface {
+	WatchPersons(in *movies_persons_service.WatchPersonsRequest,
+		stream movies_persons_service.MoviesPersonsServiceV1_WatchPersonsServer) error
+}
+
+// Registers WatchPersons as the server-sent events stream, must be registered after the generated handlers,
+// because streaming isn't supported by the generated in-process handlers
+func registerWatchPersonsHandler(mux *runtime.ServeMux, watcher personsWatcher) error {
+	return mux.HandlePath(http.MethodGet, watchPersonsPath,
+		func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+			_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+
+			in := &movies_persons_service.WatchPersonsRequest{}
+			filter := &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+			if err := runtime.PopulateQueryParameters(in, req.URL.Query(), filter); err != nil {
+				runtime.HTTPError(req.Context(), mux, outboundMarshaler, w, req,
+					status.Errorf(codes.InvalidArgument, "%v", err))
+				return
+			}
+			// sent by the reconnecting EventSource with the id of the last received event
+			if lastEventID := req.Header.Get("Last-Event-ID"); in.SinceRevision == nil && lastEventID != "" {
+				revision, err := strconv.ParseInt(lastEventID, 10, 64)
+				if err != nil {
+					runtime.HTTPError(req.Context(), mux, outboundMarshaler, w, req,
+						status.Errorf(codes.InvalidArgument, "invalid Last-Event-ID header: %v", err))
+					return
+				}
+				in.SinceRevision = &revision
+			}
+
+			ctx, cancel := context.WithCancel(req.Context())
+			defer cancel()
+			stream := &sseWatchStream{ctx: ctx, w: w, marshaler: outboundMarshaler}
+			go stream.keepAlive(ctx)
+
+			err := watcher.WatchPersons(in, stream)
+			cancel()
+			stream.mu.Lock()
+			defer stream.mu.Unlock()
+			// after the stream is started, the status can't be changed, the client reconnects with the last event id
+			if err != nil && !stream.started {
+				runtime.HTTPError(req.Context(), mux, outboundMarshaler, w, req, err)
+			}
+		})
+}
+
+// Writes the changes as the server-sent events, the response is started on the first event or keep alive comment
+type sseWatchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	w         http.ResponseWriter
+	marshaler runtime.Marshaler
+
+	// guards writes of the events and the keep alive comments
+	mu      sync.Mutex
+	started bool
+}
+
+func (s *sseWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseWatchStream) Send(change *movies_persons_service.PersonChange) error {
+	body, err := s.marshaler.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	var event bytes.Buffer
+	fmt.Fprintf(&event, "id: %d\n", change.Revision)
+	// data can't contain line breaks, each line is sent as the separate data field
+	for _, line := range bytes.Split(body, []byte("\n")) {
+		fmt.Fprintf(&event, "data: %s\n", line)
+	}
+	event.WriteByte('\n')
+	return s.write(event.Bytes())
+}
+
+func (s *sseWatchStream) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(sseKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.write([]byte(": keep-alive\n\n")); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *sseWatchStream) write(p []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+
+	if !s.started {
+		s.started = true
+		s.w.Header().Set("Content-Type", "text/event-stream")
+		s.w.Header().Set("Cache-Control", "no-cache")
+		s.w.WriteHeader(http.StatusOK)
+	}
+	if _, err := s.w.Write(p); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
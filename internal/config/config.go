@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"sync"
+	"time"
 
 	"github.com/Falokut/admin_movies_persons_service/internal/repository"
 	"github.com/Falokut/admin_movies_persons_service/pkg/jaeger"
@@ -37,9 +38,12 @@ type Config struct {
 	HealthcheckPort string `yaml:"healthcheck_port" env:"HEALTHCHECK_PORT"`
 
 	Listen struct {
-		Host string `yaml:"host" env:"HOST"`
-		Port string `yaml:"port" env:"PORT"`
-		Mode string `yaml:"server_mode" env:"SERVER_MODE"` // support GRPC, REST, BOTH
+		Host           string   `yaml:"host" env:"HOST"`
+		Port           string   `yaml:"port" env:"PORT"`
+		Mode           string   `yaml:"server_mode" env:"SERVER_MODE"` // support GRPC, REST, BOTH
+		AllowedHeaders []string `yaml:"allowed_headers"`
+		// grpc metadata keys, that are sent as the REST response headers, by the headers names
+		AllowedOutgoingHeaders map[string]string `yaml:"allowed_outgoing_headers"`
 	} `yaml:"listen"`
 
 	PrometheusConfig struct {
@@ -70,8 +74,59 @@ type Config struct {
 	DBConfig     repository.DBConfig `yaml:"db_config"`
 	JaegerConfig jaeger.Config       `yaml:"jaeger"`
 	KafkaConfig  struct {
-		Brokers []string `yaml:"brokers"`
+		Brokers      []string `yaml:"brokers"`
+		EventsFormat string   `yaml:"events_format" env:"KAFKA_EVENTS_FORMAT"`
+		SchemaID     int32    `yaml:"schema_id" env:"KAFKA_SCHEMA_ID"`
 	} `yaml:"kafka"`
+
+	EventsRelayConfig struct {
+		PollInterval time.Duration `yaml:"poll_interval" env:"EVENTS_RELAY_POLL_INTERVAL"`
+		BatchSize    int32         `yaml:"batch_size" env:"EVENTS_RELAY_BATCH_SIZE"`
+		MaxBackoff   time.Duration `yaml:"max_backoff" env:"EVENTS_RELAY_MAX_BACKOFF"`
+		MaxAttempts  int32         `yaml:"max_attempts" env:"EVENTS_RELAY_MAX_ATTEMPTS"`
+	} `yaml:"events_relay"`
+
+	DeletedPersonsPurgerConfig struct {
+		RetentionPeriod time.Duration `yaml:"retention_period" env:"DELETED_PERSONS_RETENTION_PERIOD"`
+		Interval        time.Duration `yaml:"interval" env:"DELETED_PERSONS_PURGE_INTERVAL"`
+		BatchSize       int32         `yaml:"batch_size" env:"DELETED_PERSONS_PURGE_BATCH_SIZE"`
+	} `yaml:"deleted_persons_purger"`
+
+	IdempotencyConfig struct {
+		// How long responses of the requests with the idempotency keys are stored
+		KeyTTL         time.Duration `yaml:"key_ttl" env:"IDEMPOTENCY_KEY_TTL"`
+		PendingTimeout time.Duration `yaml:"pending_timeout" env:"IDEMPOTENCY_PENDING_TIMEOUT"`
+		// Interval between removals of the expired keys
+		CleanupInterval  time.Duration `yaml:"cleanup_interval" env:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+		CleanupBatchSize int32         `yaml:"cleanup_batch_size" env:"IDEMPOTENCY_CLEANUP_BATCH_SIZE"`
+	} `yaml:"idempotency"`
+
+	ImportConfig struct {
+		// Directory, photo paths in the imported files are relative to, photo paths aren't allowed if empty
+		PhotosDir            string        `yaml:"photos_dir" env:"IMPORT_PHOTOS_DIR"`
+		MaxPhotoSize         int64         `yaml:"max_photo_size" env:"IMPORT_MAX_PHOTO_SIZE"`
+		PhotoDownloadTimeout time.Duration `yaml:"photo_download_timeout" env:"IMPORT_PHOTO_DOWNLOAD_TIMEOUT"`
+		// Hosts, photos can be downloaded from, any public host is allowed if empty
+		PhotoAllowedHosts []string `yaml:"photo_allowed_hosts" env:"IMPORT_PHOTO_ALLOWED_HOSTS"`
+	} `yaml:"import"`
+
+	WatchConfig struct {
+		// Delay between checks of the new persons changes, while there are watchers
+		PollInterval time.Duration `yaml:"poll_interval" env:"WATCH_POLL_INTERVAL"`
+		BatchSize    int32         `yaml:"batch_size" env:"WATCH_BATCH_SIZE"`
+		// How long persons changes are stored, watchers can't resume from the older revisions
+		ChangesRetentionPeriod time.Duration `yaml:"changes_retention_period" env:"WATCH_CHANGES_RETENTION_PERIOD"`
+		CleanupInterval        time.Duration `yaml:"cleanup_interval" env:"WATCH_CLEANUP_INTERVAL"`
+		CleanupBatchSize       int32         `yaml:"cleanup_batch_size" env:"WATCH_CLEANUP_BATCH_SIZE"`
+	} `yaml:"watch"`
+
+	MigrationsConfig struct {
+		// UP - applies migrations on startup, VERIFY - stops startup, if migrations aren't applied, SKIP
+		OnStartup string `yaml:"on_startup" env:"MIGRATIONS_ON_STARTUP"`
+		// Role, that owns the schema, db_config credentials are used if the username isn't specified
+		Username string `yaml:"username" env:"MIGRATIONS_DB_USERNAME"`
+		Password string `yaml:"password" env:"MIGRATIONS_DB_PASSWORD"`
+	} `yaml:"migrations"`
 }
 
 var instance *Config
@@ -82,10 +137,10 @@ const configsPath = "configs/"
 func GetConfig() *Config {
 	once.Do(func() {
 		logger := logging.GetLogger()
-		instance = &Config{}
 
-		if err := cleanenv.ReadConfig(configsPath+"config.yml", instance); err != nil {
-			help, _ := cleanenv.GetDescription(instance, nil)
+		var err error
+		if instance, err = ReadConfig(configsPath + "config.yml"); err != nil {
+			help, _ := cleanenv.GetDescription(&Config{}, nil)
 			logger.Fatal(help, " ", err)
 		}
 	})
@@ -93,6 +148,15 @@ func GetConfig() *Config {
 	return instance
 }
 
+// Reads config from the yml file, values are overridden by the env variables
+func ReadConfig(path string) (*Config, error) {
+	cfg := &Config{}
+	if err := cleanenv.ReadConfig(path, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func (c ConnectionSecureConfig) GetGrpcTransportCredentials() (grpc.DialOption, error) {
 	if c.Method == Insecure {
 		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
@@ -1,15 +1,47 @@
 package events
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+type EventsFormat = string
+
+const (
+	JSONFormat     EventsFormat = "JSON"
+	ProtobufFormat EventsFormat = "PROTOBUF"
+)
 
 type KafkaConfig struct {
 	Brokers []string
+	// JSON by default
+	EventsFormat EventsFormat
+	// If > 0, protobuf events are written in the schema registry wire format with this schema id
+	SchemaID int32
+}
+
+// Event info, that is stored in the event envelope
+type EventMetadata struct {
+	ID           string
+	OccurredAt   time.Time
+	TraceContext map[string]string
 }
 
-type personDeletedEvent struct {
-	ID int32 `json:"person_id"`
+type Person struct {
+	ID         int32
+	FullnameRU string
+	FullnameEN string
+	// formatted as YYYY-MM-DD
+	Birthday string
+	Sex      string
+	PhotoID  string
 }
 
 type PersonsEventsMQ interface {
-	PersonDeleted(ctx context.Context, id int32) error
+	PersonCreated(ctx context.Context, meta EventMetadata, person Person) error
+	PersonUpdated(ctx context.Context, meta EventMetadata, before, after Person) error
+	// Sends person_updated event with the current state of the not changed person, all fields are listed as changed
+	PersonSnapshot(ctx context.Context, meta EventMetadata, person Person) error
+	PersonDeleted(ctx context.Context, meta EventMetadata, id int32) error
+	PersonMerged(ctx context.Context, meta EventMetadata, sourceIDs []int32, target Person) error
 }
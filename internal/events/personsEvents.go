@@ -2,16 +2,24 @@ package events
 
 import (
 	"context"
-	"encoding/json"
+	"encoding/binary"
 	"fmt"
+	"strconv"
+	"strings"
 
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
 	"github.com/segmentio/kafka-go"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type personsEvents struct {
 	eventsWriter *kafka.Writer
 	logger       *logrus.Logger
+	format       EventsFormat
+	schemaID     int32
 }
 
 func NewPersonsEvents(cfg KafkaConfig, logger *logrus.Logger) *personsEvents {
@@ -20,25 +28,170 @@ func NewPersonsEvents(cfg KafkaConfig, logger *logrus.Logger) *personsEvents {
 		Logger: logger,
 	}
 	w.AllowAutoTopicCreation = true
-	return &personsEvents{eventsWriter: w, logger: logger}
+
+	format := strings.ToUpper(cfg.EventsFormat)
+	if format != ProtobufFormat {
+		format = JSONFormat
+	}
+	return &personsEvents{eventsWriter: w, logger: logger, format: format, schemaID: cfg.SchemaID}
 }
 
 const (
+	personCreatedTopic = "person_created"
+	personUpdatedTopic = "person_updated"
 	personDeletedTopic = "person_deleted"
+	personMergedTopic  = "person_merged"
+)
+
+const (
+	producerName = "admin_movies_persons_service"
+	// payload schema version, must be incremented on incompatible payloads changes
+	eventsVersion = 1
 )
 
 func (e *personsEvents) Shutdown() error {
 	return e.eventsWriter.Close()
 }
 
-func (e *personsEvents) PersonDeleted(ctx context.Context, id int32) error {
-	body, err := json.Marshal(personDeletedEvent{ID: id})
+func (e *personsEvents) PersonCreated(ctx context.Context, meta EventMetadata, person Person) error {
+	return e.writeEvent(ctx, meta, personCreatedTopic, person.ID, &movies_persons_service.EventEnvelope{
+		Payload: &movies_persons_service.EventEnvelope_PersonCreated{
+			PersonCreated: &movies_persons_service.PersonCreatedEvent{Person: convertPerson(person)},
+		},
+	})
+}
+
+func (e *personsEvents) PersonUpdated(ctx context.Context, meta EventMetadata, before, after Person) error {
+	return e.writeEvent(ctx, meta, personUpdatedTopic, after.ID, &movies_persons_service.EventEnvelope{
+		Payload: &movies_persons_service.EventEnvelope_PersonUpdated{
+			PersonUpdated: &movies_persons_service.PersonUpdatedEvent{
+				PersonID:      after.ID,
+				ChangedFields: getChangedFields(before, after),
+				Before:        convertPerson(before),
+				After:         convertPerson(after),
+			},
+		},
+	})
+}
+
+func (e *personsEvents) PersonSnapshot(ctx context.Context, meta EventMetadata, person Person) error {
+	return e.writeEvent(ctx, meta, personUpdatedTopic, person.ID, &movies_persons_service.EventEnvelope{
+		Payload: &movies_persons_service.EventEnvelope_PersonUpdated{
+			PersonUpdated: &movies_persons_service.PersonUpdatedEvent{
+				PersonID:      person.ID,
+				ChangedFields: personFields,
+				Before:        convertPerson(person),
+				After:         convertPerson(person),
+				Snapshot:      true,
+			},
+		},
+	})
+}
+
+func (e *personsEvents) PersonDeleted(ctx context.Context, meta EventMetadata, id int32) error {
+	return e.writeEvent(ctx, meta, personDeletedTopic, id, &movies_persons_service.EventEnvelope{
+		Payload: &movies_persons_service.EventEnvelope_PersonDeleted{
+			PersonDeleted: &movies_persons_service.PersonDeletedEvent{PersonID: id},
+		},
+	})
+}
+
+func (e *personsEvents) PersonMerged(ctx context.Context, meta EventMetadata, sourceIDs []int32, target Person) error {
+	return e.writeEvent(ctx, meta, personMergedTopic, target.ID, &movies_persons_service.EventEnvelope{
+		Payload: &movies_persons_service.EventEnvelope_PersonMerged{
+			PersonMerged: &movies_persons_service.PersonMergedEvent{
+				SourceIDs: sourceIDs,
+				TargetID:  target.ID,
+				Target:    convertPerson(target),
+			},
+		},
+	})
+}
+
+func (e *personsEvents) writeEvent(ctx context.Context, meta EventMetadata, topic string,
+	personID int32, envelope *movies_persons_service.EventEnvelope) error {
+	envelope.EventID = meta.ID
+	envelope.EventType = topic
+	envelope.Version = eventsVersion
+	envelope.OccurredAt = timestamppb.New(meta.OccurredAt)
+	envelope.Producer = producerName
+	envelope.TraceContext = meta.TraceContext
+
+	body, contentType, err := e.marshalEnvelope(envelope)
 	if err != nil {
 		e.logger.Fatal(err)
 	}
 	return e.eventsWriter.WriteMessages(ctx, kafka.Message{
-		Topic: personDeletedTopic,
-		Key:   []byte(fmt.Sprint("person_", id)),
+		Topic: topic,
+		Key:   []byte(fmt.Sprint("person_", personID)),
 		Value: body,
+		Headers: []kafka.Header{
+			{Key: "content-type", Value: []byte(contentType)},
+			{Key: "event_id", Value: []byte(meta.ID)},
+			{Key: "event_type", Value: []byte(topic)},
+			{Key: "event_version", Value: []byte(strconv.Itoa(eventsVersion))},
+		},
 	})
 }
+
+func (e *personsEvents) marshalEnvelope(envelope *movies_persons_service.EventEnvelope) ([]byte, string, error) {
+	if e.format == JSONFormat {
+		body, err := protojson.Marshal(envelope)
+		return body, "application/json", err
+	}
+
+	body, err := proto.Marshal(envelope)
+	if err != nil || e.schemaID <= 0 {
+		return body, "application/x-protobuf", err
+	}
+
+	// schema registry wire format: magic byte, big endian schema id, message indexes and message.
+	// Envelope is the first message in the file, so indexes are encoded as single zero byte
+	framed := make([]byte, 6, 6+len(body))
+	binary.BigEndian.PutUint32(framed[1:5], uint32(e.schemaID))
+	return append(framed, body...), "application/vnd.schemaregistry.v1+protobuf", nil
+}
+
+func convertPerson(p Person) *movies_persons_service.PersonEventData {
+	converted := &movies_persons_service.PersonEventData{
+		ID:         p.ID,
+		FullnameRU: p.FullnameRU,
+	}
+	if p.FullnameEN != "" {
+		converted.FullnameEN = &p.FullnameEN
+	}
+	if p.Birthday != "" {
+		converted.Birthday = &p.Birthday
+	}
+	if p.Sex != "" {
+		converted.Sex = &p.Sex
+	}
+	if p.PhotoID != "" {
+		converted.PhotoID = &p.PhotoID
+	}
+	return converted
+}
+
+// json names of the person fields, that can be changed
+var personFields = []string{"fullname_ru", "fullname_en", "birthday", "sex", "photo_id"}
+
+// Returns json names of the fields, that differ in before and after
+func getChangedFields(before, after Person) []string {
+	var changed = make([]string, 0, len(personFields))
+	if before.FullnameRU != after.FullnameRU {
+		changed = append(changed, "fullname_ru")
+	}
+	if before.FullnameEN != after.FullnameEN {
+		changed = append(changed, "fullname_en")
+	}
+	if before.Birthday != after.Birthday {
+		changed = append(changed, "birthday")
+	}
+	if before.Sex != after.Sex {
+		changed = append(changed, "sex")
+	}
+	if before.PhotoID != after.PhotoID {
+		changed = append(changed, "photo_id")
+	}
+	return changed
+}
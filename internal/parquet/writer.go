@@ -0,0 +1,344 @@
+// Package parquet writes flat tables into the parquet files.
+// Only plain encoding without compression is supported, each column chunk is written as one data page.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+type ColumnType int
+
+const (
+	Int32 ColumnType = iota
+	Int64
+	// UTF-8 string
+	String
+	// Values are time.Time, stored as the days since the unix epoch
+	Date
+	// Values are time.Time, stored as the microseconds since the unix epoch
+	Timestamp
+)
+
+type Column struct {
+	Name string
+	Type ColumnType
+	// if true, nil values are allowed
+	Optional bool
+}
+
+var ErrInvalidValue = errors.New("invalid column value")
+
+// Physical types, converted types, encodings and other enums from the parquet format
+const (
+	physicalInt32     int32 = 1
+	physicalInt64     int32 = 2
+	physicalByteArray int32 = 6
+
+	convertedUTF8            int32 = 0
+	convertedDate            int32 = 6
+	convertedTimestampMicros int32 = 10
+
+	repetitionRequired int32 = 0
+	repetitionOptional int32 = 1
+
+	encodingPlain int32 = 0
+	encodingRLE   int32 = 3
+
+	pageTypeData int32 = 0
+)
+
+const magic = "PAR1"
+
+type columnChunk struct {
+	offset     int64
+	size       int64
+	dataOffset int64
+	numValues  int64
+}
+
+type columnBuffer struct {
+	values bytes.Buffer
+	// definition levels of the optional column, true if the value isn't null
+	defined []bool
+}
+
+type rowGroup struct {
+	columns []columnChunk
+	size    int64
+	rows    int64
+}
+
+// Writes rows into the parquet file, rows are buffered in memory until the row group is full
+type Writer struct {
+	w            io.Writer
+	columns      []Column
+	buffers      []columnBuffer
+	rowGroupSize int
+	rows         int
+	offset       int64
+	rowGroups    []rowGroup
+	totalRows    int64
+	started      bool
+}
+
+// Returns writer, that writes row groups with up to rowGroupSize rows
+func NewWriter(w io.Writer, columns []Column, rowGroupSize int) *Writer {
+	return &Writer{
+		w:            w,
+		columns:      columns,
+		buffers:      make([]columnBuffer, len(columns)),
+		rowGroupSize: rowGroupSize,
+	}
+}
+
+// Writes the row, values must be in the same order as the columns,
+// int32 for Int32, int64 for Int64, string for String, time.Time for Date and Timestamp,
+// nil for the null values of the optional columns
+func (w *Writer) Write(row ...any) error {
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("%w: row must contain %d values", ErrInvalidValue, len(w.columns))
+	}
+	for i, value := range row {
+		if err := w.appendValue(i, value); err != nil {
+			return err
+		}
+	}
+
+	w.rows++
+	if w.rows >= w.rowGroupSize {
+		return w.flushRowGroup()
+	}
+	return nil
+}
+
+// Writes the buffered rows and the file footer, the underlying writer isn't closed
+func (w *Writer) Close() error {
+	if err := w.writeMagic(); err != nil {
+		return err
+	}
+	if w.rows > 0 {
+		if err := w.flushRowGroup(); err != nil {
+			return err
+		}
+	}
+
+	footer := w.fileMetadata()
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(footer)))
+	return w.write(footer, length[:], []byte(magic))
+}
+
+func (w *Writer) appendValue(i int, value any) error {
+	column, buf := w.columns[i], &w.buffers[i]
+	if value == nil {
+		if !column.Optional {
+			return fmt.Errorf("%w: column %s mustn't be null", ErrInvalidValue, column.Name)
+		}
+		buf.defined = append(buf.defined, false)
+		return nil
+	}
+
+	switch v := value.(type) {
+	case int32:
+		if column.Type != Int32 {
+			return fmt.Errorf("%w: unexpected int32 value of the column %s", ErrInvalidValue, column.Name)
+		}
+		binary.Write(&buf.values, binary.LittleEndian, v)
+	case int64:
+		if column.Type != Int64 {
+			return fmt.Errorf("%w: unexpected int64 value of the column %s", ErrInvalidValue, column.Name)
+		}
+		binary.Write(&buf.values, binary.LittleEndian, v)
+	case string:
+		if column.Type != String {
+			return fmt.Errorf("%w: unexpected string value of the column %s", ErrInvalidValue, column.Name)
+		}
+		binary.Write(&buf.values, binary.LittleEndian, uint32(len(v)))
+		buf.values.WriteString(v)
+	case time.Time:
+		switch column.Type {
+		case Date:
+			days := time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+			binary.Write(&buf.values, binary.LittleEndian, int32(days))
+		case Timestamp:
+			binary.Write(&buf.values, binary.LittleEndian, v.UnixMicro())
+		default:
+			return fmt.Errorf("%w: unexpected time value of the column %s", ErrInvalidValue, column.Name)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported type %T of the column %s", ErrInvalidValue, value, column.Name)
+	}
+
+	if column.Optional {
+		buf.defined = append(buf.defined, true)
+	}
+	return nil
+}
+
+func (w *Writer) flushRowGroup() error {
+	if err := w.writeMagic(); err != nil {
+		return err
+	}
+
+	group := rowGroup{columns: make([]columnChunk, len(w.columns)), rows: int64(w.rows)}
+	for i, column := range w.columns {
+		buf := &w.buffers[i]
+		var page bytes.Buffer
+		if column.Optional {
+			levels := encodeDefinitionLevels(buf.defined)
+			binary.Write(&page, binary.LittleEndian, uint32(len(levels)))
+			page.Write(levels)
+		}
+		page.Write(buf.values.Bytes())
+
+		header := pageHeader(page.Len(), w.rows)
+		chunk := columnChunk{
+			offset:     w.offset,
+			dataOffset: w.offset,
+			size:       int64(len(header) + page.Len()),
+			numValues:  int64(w.rows),
+		}
+		if err := w.write(header, page.Bytes()); err != nil {
+			return err
+		}
+
+		group.columns[i] = chunk
+		group.size += chunk.size
+		buf.values.Reset()
+		buf.defined = buf.defined[:0]
+	}
+
+	w.rowGroups = append(w.rowGroups, group)
+	w.totalRows += int64(w.rows)
+	w.rows = 0
+	return nil
+}
+
+func (w *Writer) writeMagic() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	return w.write([]byte(magic))
+}
+
+func (w *Writer) write(parts ...[]byte) error {
+	for _, part := range parts {
+		n, err := w.w.Write(part)
+		w.offset += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Encodes levels with the bit packed runs of the RLE/bit-packing hybrid encoding, bit width is 1
+func encodeDefinitionLevels(defined []bool) []byte {
+	groups := (len(defined) + 7) / 8
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(groups<<1|1))
+
+	levels := make([]byte, n+groups)
+	copy(levels, buf[:n])
+	for i, d := range defined {
+		if d {
+			levels[n+i/8] |= 1 << (i % 8)
+		}
+	}
+	return levels
+}
+
+func pageHeader(size int, rows int) []byte {
+	t := newThriftWriter()
+	t.i32Field(1, pageTypeData)
+	t.i32Field(2, int32(size))
+	t.i32Field(3, int32(size))
+	t.structField(5)
+	t.i32Field(1, int32(rows))
+	t.i32Field(2, encodingPlain)
+	t.i32Field(3, encodingRLE)
+	t.i32Field(4, encodingRLE)
+	t.structEnd()
+	t.structEnd()
+	return t.buf.Bytes()
+}
+
+func (w *Writer) fileMetadata() []byte {
+	t := newThriftWriter()
+	t.i32Field(1, 1)
+
+	t.listField(2, thriftStruct, len(w.columns)+1)
+	t.structBegin()
+	t.stringField(4, "schema")
+	t.i32Field(5, int32(len(w.columns)))
+	t.structEnd()
+	for _, column := range w.columns {
+		physical, converted, hasConverted := column.physicalType()
+		repetition := repetitionRequired
+		if column.Optional {
+			repetition = repetitionOptional
+		}
+
+		t.structBegin()
+		t.i32Field(1, physical)
+		t.i32Field(3, repetition)
+		t.stringField(4, column.Name)
+		if hasConverted {
+			t.i32Field(6, converted)
+		}
+		t.structEnd()
+	}
+
+	t.i64Field(3, w.totalRows)
+	t.listField(4, thriftStruct, len(w.rowGroups))
+	for _, group := range w.rowGroups {
+		t.structBegin()
+		t.listField(1, thriftStruct, len(group.columns))
+		for i, chunk := range group.columns {
+			physical, _, _ := w.columns[i].physicalType()
+			t.structBegin()
+			t.i64Field(2, chunk.offset)
+			t.structField(3)
+			t.i32Field(1, physical)
+			t.listField(2, thriftI32, 2)
+			t.i32(encodingPlain)
+			t.i32(encodingRLE)
+			t.listField(3, thriftBinary, 1)
+			t.binary(w.columns[i].Name)
+			t.i32Field(4, 0) // uncompressed
+			t.i64Field(5, chunk.numValues)
+			t.i64Field(6, chunk.size)
+			t.i64Field(7, chunk.size)
+			t.i64Field(9, chunk.dataOffset)
+			t.structEnd()
+			t.structEnd()
+		}
+		t.i64Field(2, group.size)
+		t.i64Field(3, group.rows)
+		t.structEnd()
+	}
+	t.stringField(6, "admin_movies_persons_service")
+	t.structEnd()
+	return t.buf.Bytes()
+}
+
+func (c Column) physicalType() (physical int32, converted int32, hasConverted bool) {
+	switch c.Type {
+	case Int64:
+		return physicalInt64, 0, false
+	case String:
+		return physicalByteArray, convertedUTF8, true
+	case Date:
+		return physicalInt32, convertedDate, true
+	case Timestamp:
+		return physicalInt64, convertedTimestampMicros, true
+	default:
+		return physicalInt32, 0, false
+	}
+}
@@ -0,0 +1,274 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testColumns = []Column{
+	{Name: "id", Type: Int32},
+	{Name: "fullname", Type: String},
+	{Name: "fullname_en", Type: String, Optional: true},
+	{Name: "birthday", Type: Date, Optional: true},
+	{Name: "created_at", Type: Timestamp},
+	{Name: "views", Type: Int64, Optional: true},
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	rows := [][]any{
+		{int32(1), "Андрей Тарковский", "Andrei Tarkovsky", date(1932, 4, 4),
+			time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC), int64(100)},
+		{int32(2), "Сергей Эйзенштейн", nil, date(1898, 1, 22),
+			time.Date(1965, 6, 7, 8, 9, 10, 0, time.UTC), nil},
+		{int32(3), "", "", nil, time.Unix(0, 0).UTC(), int64(-1)},
+		{int32(4), "Лев Толстой", nil, date(1969, 12, 31),
+			time.Date(1969, 12, 31, 23, 59, 59, 999999000, time.UTC), nil},
+		{int32(5), "Иван Петров", "Ivan Petrov", date(1970, 1, 1),
+			time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC), int64(0)},
+	}
+
+	for _, rowGroupSize := range []int{1, 2, len(rows), len(rows) + 1} {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, testColumns, rowGroupSize)
+		for _, row := range rows {
+			if err := w.Write(row...); err != nil {
+				t.Fatalf("row group size %d: write: %v", rowGroupSize, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("row group size %d: close: %v", rowGroupSize, err)
+		}
+
+		got := readFile(t, buf.Bytes(), testColumns)
+		if !reflect.DeepEqual(got, rows) {
+			t.Errorf("row group size %d: rows\ngot  %v\nwant %v", rowGroupSize, got, rows)
+		}
+	}
+}
+
+func TestWriterEmptyFile(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, testColumns, 10)
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if got := readFile(t, buf.Bytes(), testColumns); len(got) != 0 {
+		t.Errorf("rows: got %v, want none", got)
+	}
+}
+
+func TestWriterInvalidValues(t *testing.T) {
+	tests := []struct {
+		name string
+		row  []any
+	}{
+		{"missing value", []any{int32(1), "name", nil, nil, time.Now()}},
+		{"null required value", []any{int32(1), nil, nil, nil, time.Now(), nil}},
+		{"unexpected type", []any{int64(1), "name", nil, nil, time.Now(), nil}},
+		{"time in the string column", []any{int32(1), time.Now(), nil, nil, time.Now(), nil}},
+		{"unsupported type", []any{int32(1), "name", nil, nil, time.Now(), 1.5}},
+	}
+
+	for _, tt := range tests {
+		w := NewWriter(&bytes.Buffer{}, testColumns, 10)
+		if err := w.Write(tt.row...); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, ErrInvalidValue)
+		}
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Parses the footer and the pages of the file and returns the rows
+func readFile(t *testing.T, data []byte, columns []Column) [][]any {
+	t.Helper()
+	if len(data) < 12 || string(data[:4]) != magic || string(data[len(data)-4:]) != magic {
+		t.Fatalf("file must start and end with %s", magic)
+	}
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footerStart := len(data) - 8 - footerLen
+	r := &thriftReader{b: data, i: footerStart}
+	meta := r.structValue()
+	if r.i != len(data)-8 {
+		t.Fatalf("footer ends at %d, want %d", r.i, len(data)-8)
+	}
+
+	schema := meta[2].([]any)
+	if len(schema) != len(columns)+1 {
+		t.Fatalf("schema has %d elements, want %d", len(schema), len(columns)+1)
+	}
+	for i, column := range columns {
+		element := schema[i+1].(map[int16]any)
+		physical, converted, hasConverted := column.physicalType()
+		repetition := repetitionRequired
+		if column.Optional {
+			repetition = repetitionOptional
+		}
+		if element[4] != column.Name || element[1] != int64(physical) || element[3] != int64(repetition) {
+			t.Fatalf("schema element %d: got %v, want column %+v", i, element, column)
+		}
+		if got, ok := element[6]; ok != hasConverted || (ok && got != int64(converted)) {
+			t.Fatalf("schema element %d: converted type %v, want %d", i, got, converted)
+		}
+	}
+
+	rows := [][]any{}
+	groups, _ := meta[4].([]any)
+	for _, g := range groups {
+		group := g.(map[int16]any)
+		groupRows := int(group[3].(int64))
+		chunks := group[1].([]any)
+		groupValues := make([][]any, len(columns))
+		for i, c := range chunks {
+			chunkMeta := c.(map[int16]any)[3].(map[int16]any)
+			groupValues[i] = readChunk(t, data, chunkMeta, columns[i], groupRows)
+		}
+		for row := 0; row < groupRows; row++ {
+			values := make([]any, len(columns))
+			for i := range columns {
+				values[i] = groupValues[i][row]
+			}
+			rows = append(rows, values)
+		}
+	}
+
+	if int(meta[3].(int64)) != len(rows) {
+		t.Fatalf("footer has %d rows, row groups have %d", meta[3], len(rows))
+	}
+	return rows
+}
+
+func readChunk(t *testing.T, data []byte, chunkMeta map[int16]any, column Column, rows int) []any {
+	t.Helper()
+	offset := int(chunkMeta[9].(int64))
+	r := &thriftReader{b: data, i: offset}
+	header := r.structValue()
+	pageSize := int(header[3].(int64))
+	if size := int(chunkMeta[6].(int64)); size != r.i-offset+pageSize {
+		t.Fatalf("column %s: chunk size %d, header and page size %d", column.Name, size, r.i-offset+pageSize)
+	}
+	if n := header[5].(map[int16]any)[1].(int64); int(n) != rows {
+		t.Fatalf("column %s: page has %d values, want %d", column.Name, n, rows)
+	}
+
+	page := data[r.i : r.i+pageSize]
+	defined := make([]bool, rows)
+	for i := range defined {
+		defined[i] = true
+	}
+	if column.Optional {
+		levelsLen := int(binary.LittleEndian.Uint32(page))
+		levels := page[4 : 4+levelsLen]
+		runHeader, n := binary.Uvarint(levels)
+		if runHeader&1 != 1 || int(runHeader>>1) != (rows+7)/8 {
+			t.Fatalf("column %s: unexpected definition levels run header %d", column.Name, runHeader)
+		}
+		for i := range defined {
+			defined[i] = levels[n+i/8]>>(i%8)&1 == 1
+		}
+		page = page[4+levelsLen:]
+	}
+
+	values := make([]any, rows)
+	for i := range values {
+		if !defined[i] {
+			continue
+		}
+		switch column.Type {
+		case Int32:
+			values[i] = int32(binary.LittleEndian.Uint32(page))
+			page = page[4:]
+		case Date:
+			days := int32(binary.LittleEndian.Uint32(page))
+			values[i] = time.Unix(int64(days)*24*60*60, 0).UTC()
+			page = page[4:]
+		case Int64:
+			values[i] = int64(binary.LittleEndian.Uint64(page))
+			page = page[8:]
+		case Timestamp:
+			values[i] = time.UnixMicro(int64(binary.LittleEndian.Uint64(page))).UTC()
+			page = page[8:]
+		case String:
+			n := binary.LittleEndian.Uint32(page)
+			values[i] = string(page[4 : 4+n])
+			page = page[4+n:]
+		}
+	}
+	if len(page) != 0 {
+		t.Fatalf("column %s: %d bytes left after the values", column.Name, len(page))
+	}
+	return values
+}
+
+// Decodes the thrift compact protocol, integers are returned as int64, binaries as string,
+// lists as []any and structs as the map of the field ids to the values
+type thriftReader struct {
+	b []byte
+	i int
+}
+
+func (r *thriftReader) structValue() map[int16]any {
+	fields := map[int16]any{}
+	var last int16
+	for {
+		header := r.b[r.i]
+		r.i++
+		if header == 0 {
+			return fields
+		}
+		typ := header & 0x0F
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			id = int16(r.zigzag())
+		}
+		last = id
+		fields[id] = r.value(typ)
+	}
+}
+
+func (r *thriftReader) value(typ byte) any {
+	switch typ {
+	case 1, 2:
+		return typ == 1
+	case thriftI32, thriftI64:
+		return r.zigzag()
+	case thriftBinary:
+		n := int(r.uvarint())
+		v := string(r.b[r.i : r.i+n])
+		r.i += n
+		return v
+	case thriftList:
+		header := r.b[r.i]
+		r.i++
+		size := int(header >> 4)
+		if size == 15 {
+			size = int(r.uvarint())
+		}
+		list := make([]any, size)
+		for i := range list {
+			list[i] = r.value(header & 0x0F)
+		}
+		return list
+	case thriftStruct:
+		return r.structValue()
+	default:
+		panic("unsupported thrift type")
+	}
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.b[r.i:])
+	r.i += n
+	return v
+}
+
+func (r *thriftReader) zigzag() int64 {
+	v := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
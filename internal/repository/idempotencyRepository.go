@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+)
+
+type idempotencyRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+const (
+	idempotencyKeysTableName = "idempotency_keys"
+	// Number of attempts to reserve the key, the existing key can be removed between the insert and the select
+	reserveIdempotencyKeyAttempts = 2
+)
+
+func NewIdempotencyRepository(db *sqlx.DB, logger *logrus.Logger) *idempotencyRepository {
+	return &idempotencyRepository{db: db, logger: logger}
+}
+
+func (r *idempotencyRepository) ReserveIdempotencyKey(ctx context.Context, key IdempotencyKey,
+	expiredBefore, pendingBefore time.Time) (IdempotencyRecord, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "idempotencyRepository.ReserveIdempotencyKey")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	insertQuery := fmt.Sprintf("INSERT INTO %[1]s (key, method, actor, request_hash) VALUES($1, $2, $3, $4) "+
+		"ON CONFLICT (key, method, actor) DO UPDATE SET request_hash=EXCLUDED.request_hash, response=NULL, created_at=NOW() "+
+		"WHERE %[1]s.created_at < $5 OR (%[1]s.response IS NULL AND %[1]s.created_at < $6) RETURNING key",
+		idempotencyKeysTableName)
+	selectQuery := fmt.Sprintf("SELECT key, method, actor, request_hash, response, created_at FROM %s "+
+		"WHERE key=$1 AND method=$2 AND actor=$3", idempotencyKeysTableName)
+
+	for i := 0; i < reserveIdempotencyKeyAttempts; i++ {
+		var reserved string
+		err = r.db.GetContext(ctx, &reserved, insertQuery, key.Key, key.Method, key.Actor, key.RequestHash,
+			expiredBefore, pendingBefore)
+		if err == nil {
+			return IdempotencyRecord{}, true, nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.Errorf("%v query: %s args: %v %v %v", err.Error(), insertQuery, key.Key, key.Method, key.Actor)
+			return IdempotencyRecord{}, false, err
+		}
+
+		var record IdempotencyRecord
+		err = r.db.GetContext(ctx, &record, selectQuery, key.Key, key.Method, key.Actor)
+		if err == nil {
+			return record, false, nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			r.logger.Errorf("%v query: %s args: %v %v %v", err.Error(), selectQuery, key.Key, key.Method, key.Actor)
+			return IdempotencyRecord{}, false, err
+		}
+	}
+	return IdempotencyRecord{}, false, err
+}
+
+func (r *idempotencyRepository) SaveIdempotentResponse(ctx context.Context,
+	key IdempotencyKey, response []byte) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "idempotencyRepository.SaveIdempotentResponse")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("UPDATE %s SET response=$4 WHERE key=$1 AND method=$2 AND actor=$3", idempotencyKeysTableName)
+	_, err = r.db.ExecContext(ctx, query, key.Key, key.Method, key.Actor, response)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v %v %v", err.Error(), query, key.Key, key.Method, key.Actor)
+		return err
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) ReleaseIdempotencyKey(ctx context.Context, key IdempotencyKey) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "idempotencyRepository.ReleaseIdempotencyKey")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE key=$1 AND method=$2 AND actor=$3 AND response IS NULL",
+		idempotencyKeysTableName)
+	_, err = r.db.ExecContext(ctx, query, key.Key, key.Method, key.Actor)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v %v %v", err.Error(), query, key.Key, key.Method, key.Actor)
+		return err
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context,
+	createdBefore time.Time, limit int32) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "idempotencyRepository.DeleteExpiredIdempotencyKeys")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("DELETE FROM %[1]s WHERE (key, method, actor) IN "+
+		"(SELECT key, method, actor FROM %[1]s WHERE created_at < $1 LIMIT %[2]d)", idempotencyKeysTableName, limit)
+	res, err := r.db.ExecContext(ctx, query, createdBefore)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, createdBefore)
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,89 @@
+-- tables and indexes are created only if they don't exist to adopt the databases, initialized before the migrations.
+-- Such databases have the persons table without the columns, added later, so they are added before the indexes.
+-- The migration has no down file, because it can't distinguish the adopted tables from the created ones
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS persons (
+    id SERIAL PRIMARY KEY,
+    fullname_ru TEXT NOT NULL,
+    fullname_en TEXT,
+    birthday DATE,
+    sex TEXT,
+    photo_id TEXT
+);
+
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+-- incremented on every change of the person, used for the optimistic concurrency control
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS created_by TEXT;
+ALTER TABLE persons ADD COLUMN IF NOT EXISTS updated_by TEXT;
+
+CREATE INDEX IF NOT EXISTS persons_deleted_at_idx ON persons (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS persons_fullname_ru_idx ON persons (fullname_ru, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS persons_fullname_en_idx ON persons (fullname_en, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS persons_birthday_idx ON persons (birthday, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS persons_created_at_idx ON persons (created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS persons_updated_at_idx ON persons (updated_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS persons_fullname_ru_trgm_idx ON persons USING GIN (LOWER(fullname_ru) gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS persons_fullname_en_trgm_idx ON persons USING GIN (LOWER(fullname_en) gin_trgm_ops) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS person_revisions (
+    person_id INT NOT NULL,
+    revision INT NOT NULL,
+    action TEXT NOT NULL,
+    fullname_ru TEXT NOT NULL,
+    fullname_en TEXT,
+    birthday DATE,
+    sex TEXT,
+    photo_id TEXT,
+    changed_by TEXT,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (person_id, revision)
+);
+
+CREATE TABLE IF NOT EXISTS persons_redirects (
+    from_id INT PRIMARY KEY,
+    to_id INT NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS persons_redirects_to_id_idx ON persons_redirects (to_id);
+
+CREATE TABLE IF NOT EXISTS persons_events_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    event_type TEXT NOT NULL,
+    person_id INT NOT NULL,
+    payload JSONB,
+    trace_context JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT
+);
+
+CREATE INDEX IF NOT EXISTS persons_events_outbox_unsent_idx ON persons_events_outbox (id) WHERE sent_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key TEXT NOT NULL,
+    method TEXT NOT NULL,
+    request_hash BYTEA NOT NULL,
+    -- serialized response, null while the request is in progress
+    response BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (key, method)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_created_at_idx ON idempotency_keys (created_at);
+
+GRANT SELECT, UPDATE, DELETE, INSERT ON persons TO admin_movies_persons_service;
+GRANT USAGE, SELECT ON SEQUENCE  persons_id_seq TO admin_movies_persons_service;
+GRANT SELECT, INSERT ON person_revisions TO admin_movies_persons_service;
+GRANT SELECT, UPDATE, INSERT ON persons_redirects TO admin_movies_persons_service;
+GRANT SELECT, UPDATE, DELETE, INSERT ON persons_events_outbox TO admin_movies_persons_service;
+GRANT USAGE, SELECT ON SEQUENCE  persons_events_outbox_id_seq TO admin_movies_persons_service;
+GRANT SELECT, UPDATE, DELETE, INSERT ON idempotency_keys TO admin_movies_persons_service;
+GRANT SELECT ON schema_migrations TO admin_movies_persons_service;
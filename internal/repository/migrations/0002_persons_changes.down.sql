@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS persons_changes;
+DROP TABLE IF EXISTS persons_changes_compaction;
+DROP FUNCTION IF EXISTS persons_changes_assign_revision();
+DROP SEQUENCE IF EXISTS persons_changes_revision_seq;
@@ -0,0 +1,52 @@
+-- log of the persons changes, that is streamed by WatchPersons
+CREATE TABLE persons_changes (
+    id BIGSERIAL PRIMARY KEY,
+    -- assigned on the commit, so revisions increase in the commit order, null only inside the writing transaction
+    revision BIGINT UNIQUE,
+    -- action of the person revision, that is added with the change
+    action TEXT NOT NULL,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- state of the person after the change
+    person_id INT NOT NULL,
+    fullname_ru TEXT NOT NULL,
+    fullname_en TEXT,
+    birthday DATE,
+    sex TEXT,
+    photo_id TEXT,
+    deleted_at TIMESTAMP,
+    version INT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    created_by TEXT,
+    updated_by TEXT
+);
+
+CREATE INDEX persons_changes_changed_at_idx ON persons_changes (changed_at);
+
+-- the greatest revision of the removed changes, watchers can't resume from the smaller revisions
+CREATE TABLE persons_changes_compaction (
+    compacted_revision BIGINT NOT NULL
+);
+
+INSERT INTO persons_changes_compaction (compacted_revision) VALUES (0);
+
+CREATE SEQUENCE persons_changes_revision_seq;
+
+-- The trigger is deferred, so the lock is taken only while the transaction commits and it isn't held
+-- while the transaction waits for the persons rows. Lock is released after the commit,
+-- so the readers never see the change with the bigger revision before the change with the smaller one.
+CREATE FUNCTION persons_changes_assign_revision() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(7318402252);
+    UPDATE persons_changes SET revision = nextval('persons_changes_revision_seq') WHERE id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER persons_changes_assign_revision AFTER INSERT ON persons_changes
+    DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION persons_changes_assign_revision();
+
+GRANT SELECT, UPDATE, DELETE, INSERT ON persons_changes TO admin_movies_persons_service;
+GRANT SELECT, UPDATE ON persons_changes_compaction TO admin_movies_persons_service;
+GRANT USAGE, SELECT ON SEQUENCE persons_changes_id_seq TO admin_movies_persons_service;
+GRANT USAGE, SELECT ON SEQUENCE persons_changes_revision_seq TO admin_movies_persons_service;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS persons_events_outbox_unsent_idx;
+CREATE INDEX persons_events_outbox_unsent_idx ON persons_events_outbox (id) WHERE sent_at IS NULL;
+
+ALTER TABLE persons_events_outbox DROP COLUMN IF EXISTS failed_at;
@@ -0,0 +1,5 @@
+-- events, that weren't published after the max number of attempts, they are skipped by the relay
+ALTER TABLE persons_events_outbox ADD COLUMN failed_at TIMESTAMP;
+
+DROP INDEX IF EXISTS persons_events_outbox_unsent_idx;
+CREATE INDEX persons_events_outbox_unsent_idx ON persons_events_outbox (id) WHERE sent_at IS NULL AND failed_at IS NULL;
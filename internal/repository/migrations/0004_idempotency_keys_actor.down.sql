@@ -0,0 +1,7 @@
+-- keys of the different actors can't be stored with the same key and method, only one of them is kept
+DELETE FROM idempotency_keys k USING idempotency_keys other
+WHERE k.key = other.key AND k.method = other.method AND k.actor > other.actor;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS actor;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key, method);
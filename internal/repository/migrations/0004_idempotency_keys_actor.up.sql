@@ -0,0 +1,6 @@
+-- keys are unique per actor, so the clients can't get the responses of the other clients by reusing their keys,
+-- requests without the actor share the empty actor
+ALTER TABLE idempotency_keys ADD COLUMN actor TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key, method, actor);
@@ -0,0 +1,339 @@
+package repository
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+const (
+	schemaMigrationsTableName = "schema_migrations"
+	// Key of the advisory lock, that is held while migrations are applied, so replicas don't migrate concurrently
+	migrationsLockKey = 7318402251
+)
+
+var (
+	ErrUnknownMigration  = errors.New("unknown migration version")
+	ErrPendingMigrations = errors.New("database has not applied migrations")
+)
+
+// Migration files are named <version>_<name>.up.sql and <version>_<name>.down.sql
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	up      string
+	down    string
+}
+
+type MigrationStatus struct {
+	Version int64
+	Name    string
+	Applied bool
+	// zero if the migration isn't applied
+	AppliedAt time.Time
+	// migration is applied, but it isn't embedded in the service, the database schema is newer than the service
+	Unknown bool
+}
+
+type appliedMigration struct {
+	Version   int64     `db:"version"`
+	Name      string    `db:"name"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+type migrator struct {
+	db         *sqlx.DB
+	logger     *logrus.Logger
+	migrations []Migration
+}
+
+func NewMigrator(db *sqlx.DB, logger *logrus.Logger) (*migrator, error) {
+	migrations, err := loadMigrations(migrationsFS, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	return &migrator{db: db, logger: logger, migrations: migrations}, nil
+}
+
+// Returns migrations sorted by version
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration, len(entries))
+	for _, entry := range entries {
+		match := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %s", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in the file name %s", entry.Name())
+		}
+
+		body, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migrations %s and %s have the same version", migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.up = string(body)
+		} else {
+			migration.down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Returns statuses of the embedded and the unknown applied migrations sorted by version
+func (m *migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.getAppliedMigrations(ctx, m.db)
+	if err != nil {
+		return []MigrationStatus{}, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if a, ok := applied[migration.Version]; ok {
+			status.Applied, status.AppliedAt = true, a.AppliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, a := range applied {
+		statuses = append(statuses, MigrationStatus{Version: a.Version, Name: a.Name,
+			Applied: true, AppliedAt: a.AppliedAt, Unknown: true})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, nil
+}
+
+// Returns ErrPendingMigrations, if some of the embedded migrations aren't applied
+func (m *migrator) Verify(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending := make([]int64, 0, len(statuses))
+	for _, status := range statuses {
+		if !status.Applied {
+			pending = append(pending, status.Version)
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w, versions: %v", ErrPendingMigrations, pending)
+	}
+	return nil
+}
+
+// Applies all not applied migrations
+func (m *migrator) Up(ctx context.Context) error {
+	return m.migrate(ctx, func(applied []int64) int64 {
+		var target int64
+		if len(m.migrations) > 0 {
+			target = m.migrations[len(m.migrations)-1].Version
+		}
+		// unknown migrations, applied by the newer service, aren't reverted
+		if len(applied) > 0 && applied[len(applied)-1] > target {
+			target = applied[len(applied)-1]
+		}
+		return target
+	})
+}
+
+// Reverts the last steps applied migrations
+func (m *migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("%s error: %w", "steps must be > 0", ErrInvalidArgument)
+	}
+	return m.migrate(ctx, func(applied []int64) int64 {
+		if steps >= len(applied) {
+			return 0
+		}
+		return applied[len(applied)-steps-1]
+	})
+}
+
+// Applies or reverts migrations, so the version is the last applied migration, 0 reverts all migrations
+func (m *migrator) To(ctx context.Context, version int64) error {
+	if version < 0 {
+		return fmt.Errorf("%s error: %w", "version must be >= 0", ErrInvalidArgument)
+	}
+	if _, ok := m.getMigration(version); version != 0 && !ok {
+		return fmt.Errorf("%w %d", ErrUnknownMigration, version)
+	}
+	return m.migrate(ctx, func([]int64) int64 { return version })
+}
+
+// Applies migrations with versions <= target and reverts migrations with versions > target in one transaction.
+// getTarget receives sorted versions of the applied migrations.
+func (m *migrator) migrate(ctx context.Context, getTarget func(applied []int64) int64) error {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		m.logger.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// transaction level lock is used, so it works through the pgbouncer in the transaction pooling mode
+	_, err = tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", int64(migrationsLockKey))
+	if err != nil {
+		m.logger.Errorf("%v while acquiring migrations lock", err.Error())
+		return err
+	}
+
+	query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (version BIGINT PRIMARY KEY, name TEXT NOT NULL, "+
+		"applied_at TIMESTAMP NOT NULL DEFAULT NOW())", schemaMigrationsTableName)
+	if _, err = tx.ExecContext(ctx, query); err != nil {
+		m.logger.Errorf("%v query: %s", err.Error(), query)
+		return err
+	}
+
+	applied, err := m.getAppliedMigrations(ctx, tx)
+	if err != nil {
+		return err
+	}
+	appliedVersions := make([]int64, 0, len(applied))
+	for version := range applied {
+		appliedVersions = append(appliedVersions, version)
+	}
+	sort.Slice(appliedVersions, func(i, j int) bool { return appliedVersions[i] < appliedVersions[j] })
+
+	target := getTarget(appliedVersions)
+
+	for i := len(appliedVersions) - 1; i >= 0 && appliedVersions[i] > target; i-- {
+		migration, ok := m.getMigration(appliedVersions[i])
+		if !ok {
+			return fmt.Errorf("%w %d, it can't be reverted", ErrUnknownMigration, appliedVersions[i])
+		}
+		if err = m.revertMigration(ctx, tx, migration); err != nil {
+			return err
+		}
+	}
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok || migration.Version > target {
+			continue
+		}
+		if err = m.applyMigration(ctx, tx, migration); err != nil {
+			return err
+		}
+	}
+
+	for _, version := range appliedVersions {
+		if _, ok := m.getMigration(version); !ok && version <= target {
+			m.logger.Warnf("applied migration %d_%s is unknown, database schema is newer than the service",
+				version, applied[version].Name)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		m.logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (m *migrator) applyMigration(ctx context.Context, tx *sqlx.Tx, migration Migration) error {
+	m.logger.Infof("applying migration %d_%s", migration.Version, migration.Name)
+	if _, err := tx.ExecContext(ctx, migration.up); err != nil {
+		m.logger.Errorf("%v while applying migration %d_%s", err.Error(), migration.Version, migration.Name)
+		return err
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (version, name) VALUES($1, $2)", schemaMigrationsTableName)
+	if _, err := tx.ExecContext(ctx, query, migration.Version, migration.Name); err != nil {
+		m.logger.Errorf("%v query: %s args: %v", err.Error(), query, migration.Version)
+		return err
+	}
+	return nil
+}
+
+func (m *migrator) revertMigration(ctx context.Context, tx *sqlx.Tx, migration Migration) error {
+	if migration.down == "" {
+		return fmt.Errorf("migration %d_%s has no down file, it can't be reverted", migration.Version, migration.Name)
+	}
+
+	m.logger.Infof("reverting migration %d_%s", migration.Version, migration.Name)
+	if _, err := tx.ExecContext(ctx, migration.down); err != nil {
+		m.logger.Errorf("%v while reverting migration %d_%s", err.Error(), migration.Version, migration.Name)
+		return err
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE version=$1", schemaMigrationsTableName)
+	if _, err := tx.ExecContext(ctx, query, migration.Version); err != nil {
+		m.logger.Errorf("%v query: %s args: %v", err.Error(), query, migration.Version)
+		return err
+	}
+	return nil
+}
+
+// Returns applied migrations by versions, returns empty map if migrations table doesn't exist
+func (m *migrator) getAppliedMigrations(ctx context.Context,
+	q sqlx.QueryerContext) (map[int64]appliedMigration, error) {
+	var exists bool
+	err := sqlx.GetContext(ctx, q, &exists, "SELECT to_regclass($1) IS NOT NULL", schemaMigrationsTableName)
+	if err != nil {
+		m.logger.Errorf("%v while checking migrations table", err.Error())
+		return nil, err
+	}
+	if !exists {
+		return map[int64]appliedMigration{}, nil
+	}
+
+	query := fmt.Sprintf("SELECT version, name, applied_at FROM %s", schemaMigrationsTableName)
+	var applied []appliedMigration
+	if err = sqlx.SelectContext(ctx, q, &applied, query); err != nil {
+		m.logger.Errorf("%v query: %s", err.Error(), query)
+		return nil, err
+	}
+
+	byVersion := make(map[int64]appliedMigration, len(applied))
+	for _, a := range applied {
+		byVersion[a.Version] = a
+	}
+	return byVersion, nil
+}
+
+func (m *migrator) getMigration(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"io/fs"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	file := func(body string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(body)}
+	}
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []Migration
+		wantErr bool
+	}{
+		{"sorted by version", fstest.MapFS{
+			"migrations/0010_add_index.up.sql":      file("CREATE INDEX"),
+			"migrations/0010_add_index.down.sql":    file("DROP INDEX"),
+			"migrations/0002_add_column.up.sql":     file("ALTER TABLE"),
+			"migrations/0001_initial_schema.up.sql": file("CREATE TABLE"),
+		}, []Migration{
+			{Version: 1, Name: "initial_schema", up: "CREATE TABLE"},
+			{Version: 2, Name: "add_column", up: "ALTER TABLE"},
+			{Version: 10, Name: "add_index", up: "CREATE INDEX", down: "DROP INDEX"},
+		}, false},
+		{"empty dir", fstest.MapFS{"migrations": &fstest.MapFile{Mode: fs.ModeDir}}, []Migration{}, false},
+		{"duplicate version", fstest.MapFS{
+			"migrations/0001_initial_schema.up.sql": file("CREATE TABLE"),
+			"migrations/0001_another_schema.up.sql": file("CREATE TABLE"),
+		}, nil, true},
+		{"missing up file", fstest.MapFS{
+			"migrations/0001_initial_schema.up.sql": file("CREATE TABLE"),
+			"migrations/0002_add_column.down.sql":   file("ALTER TABLE"),
+		}, nil, true},
+		{"empty up file", fstest.MapFS{"migrations/0001_initial_schema.up.sql": file("")}, nil, true},
+		{"invalid file name", fstest.MapFS{"migrations/initial_schema.sql": file("CREATE TABLE")}, nil, true},
+		{"invalid direction", fstest.MapFS{"migrations/0001_initial_schema.redo.sql": file("CREATE TABLE")}, nil, true},
+		{"zero version", fstest.MapFS{"migrations/0000_initial_schema.up.sql": file("CREATE TABLE")}, nil, true},
+		{"missing dir", fstest.MapFS{}, nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := loadMigrations(tt.files, "migrations")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: got error %v, want error %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got migrations %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLoadEmbeddedMigrations(t *testing.T) {
+	migrations, err := loadMigrations(migrationsFS, "migrations")
+	if err != nil {
+		t.Fatalf("load embedded migrations: %v", err)
+	}
+	for i, migration := range migrations {
+		if migration.Version != int64(i+1) {
+			t.Errorf("migration %s has version %d, want %d", migration.Name, migration.Version, i+1)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+)
+
+type outboxRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+const (
+	outboxTableName = "persons_events_outbox"
+)
+
+func NewOutboxRepository(db *sqlx.DB, logger *logrus.Logger) *outboxRepository {
+	return &outboxRepository{db: db, logger: logger}
+}
+
+func (r *outboxRepository) HandleUnsentEvents(ctx context.Context, limit, maxAttempts int32,
+	handle func(ctx context.Context, event OutboxEvent) error) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "outboxRepository.HandleUnsentEvents")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("SELECT id, event_id, event_type, person_id, payload, trace_context, created_at, attempts FROM %s "+
+		"WHERE sent_at IS NULL AND failed_at IS NULL ORDER BY id LIMIT %d FOR UPDATE SKIP LOCKED", outboxTableName, limit)
+
+	var events []OutboxEvent
+	err = tx.SelectContext(ctx, &events, query)
+	if err != nil {
+		r.logger.Errorf("%v query: %s", err.Error(), query)
+		return 0, err
+	}
+
+	handled := 0
+	for _, event := range events {
+		if handleErr := handle(ctx, event); handleErr != nil {
+			// the event, that can't be published, is moved to the failed events, so it doesn't block the next events
+			failed := event.Attempts+1 >= maxAttempts
+			query = fmt.Sprintf("UPDATE %s SET attempts=attempts+1, last_error=$2, "+
+				"failed_at=CASE WHEN $3 THEN NOW() END WHERE id=$1", outboxTableName)
+			_, err = tx.ExecContext(ctx, query, event.ID, handleErr.Error(), failed)
+			if err != nil {
+				r.logger.Errorf("%v query: %s args: %v", err.Error(), query, event.ID)
+				return 0, err
+			}
+			if !failed {
+				break
+			}
+			r.logger.Errorf("event %d is marked as failed after %d attempts: %v", event.ID, event.Attempts+1, handleErr)
+			handled++
+			continue
+		}
+
+		query = fmt.Sprintf("UPDATE %s SET sent_at=NOW() WHERE id=$1", outboxTableName)
+		_, err = tx.ExecContext(ctx, query, event.ID)
+		if err != nil {
+			r.logger.Errorf("%v query: %s args: %v", err.Error(), query, event.ID)
+			return 0, err
+		}
+		handled++
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+	return handled, nil
+}
+
+// Adds events to the outbox as part of tx, events will be published after tx commit.
+func insertOutboxEvents(ctx context.Context, tx *sqlx.Tx, eventType string, personsIDs []int32) error {
+	if len(personsIDs) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (event_type, person_id, trace_context) SELECT $1, UNNEST($2::INT[]), $3",
+		outboxTableName)
+	_, err := tx.ExecContext(ctx, query, eventType, personsIDs, getTraceContext(ctx))
+	return err
+}
+
+// Adds event with payload to the outbox as part of tx, event will be published after tx commit.
+func insertOutboxEvent(ctx context.Context, tx *sqlx.Tx, eventType string, personID int32, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (event_type, person_id, payload, trace_context) VALUES($1, $2, $3, $4)",
+		outboxTableName)
+	_, err = tx.ExecContext(ctx, query, eventType, personID, string(body), getTraceContext(ctx))
+	return err
+}
+
+// Returns json encoded text map of the span from ctx or nil, if ctx has no span
+func getTraceContext(ctx context.Context) any {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+
+	carrier := opentracing.TextMapCarrier{}
+	if err := span.Tracer().Inject(span.Context(), opentracing.TextMap, carrier); err != nil || len(carrier) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(carrier)
+	if err != nil {
+		return nil
+	}
+	return string(body)
+}
+
+func newPersonSnapshot(p Person) (*PersonSnapshot, error) {
+	id, err := strconv.Atoi(p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &PersonSnapshot{
+		ID:         int32(id),
+		FullnameRU: p.FullnameRU,
+		FullnameEN: p.FullnameEN.String,
+		Sex:        p.Sex.String,
+		PhotoID:    p.PhotoID.String,
+	}
+	if p.Birthday.Valid {
+		snapshot.Birthday = &p.Birthday.Time
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	personRevisionsTableName = "person_revisions"
+)
+
+func (r *personsRepository) GetPersonRevisions(ctx context.Context,
+	personID int32, limit, offset int32) ([]PersonRevision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetPersonRevisions")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE person_id=$1 ORDER BY revision DESC LIMIT %d OFFSET %d",
+		personRevisionsTableName, limit, offset)
+
+	var revisions []PersonRevision
+	err = r.db.SelectContext(ctx, &revisions, query, personID)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, personID)
+		return []PersonRevision{}, err
+	} else if len(revisions) == 0 {
+		return []PersonRevision{}, ErrNotFound
+	}
+
+	return revisions, nil
+}
+
+func (r *personsRepository) GetPersonRevision(ctx context.Context,
+	personID int32, revision int32) (PersonRevision, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetPersonRevision")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil && !errors.Is(err, sql.ErrNoRows))
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE person_id=$1 AND revision=$2", personRevisionsTableName)
+
+	var personRevision PersonRevision
+	err = r.db.GetContext(ctx, &personRevision, query, personID, revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return PersonRevision{}, ErrNotFound
+	} else if err != nil {
+		r.logger.Errorf("%v query: %s args: %v %v", err.Error(), query, personID, revision)
+		return PersonRevision{}, err
+	}
+
+	return personRevision, nil
+}
+
+func (r *personsRepository) RollbackPerson(ctx context.Context, personID int32, revision int32) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.RollbackPerson")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id=$1 AND deleted_at IS NULL FOR UPDATE", personsTableName)
+	var beforeRollback Person
+	err = tx.GetContext(ctx, &beforeRollback, query, personID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	} else if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, personID)
+		return err
+	}
+
+	query = fmt.Sprintf("UPDATE %[1]s SET fullname_ru=rev.fullname_ru, fullname_en=rev.fullname_en, "+
+		"birthday=rev.birthday, sex=rev.sex, photo_id=rev.photo_id, version=%[1]s.version+1, "+
+		"updated_at=NOW(), updated_by=$3 FROM %[2]s rev "+
+		"WHERE %[1]s.id=$1 AND rev.person_id=$1 AND rev.revision=$2 RETURNING %[1]s.*",
+		personsTableName, personRevisionsTableName)
+	var afterRollback Person
+	err = tx.GetContext(ctx, &afterRollback, query, personID, revision, getActorFromContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	} else if err != nil {
+		r.logger.Errorf("%v query: %s args: %v %v", err.Error(), query, personID, revision)
+		return err
+	}
+
+	err = insertPersonRevision(ctx, tx, PersonRolledBackAction, afterRollback)
+	if err != nil {
+		r.logger.Errorf("%v while adding person revision, args: %v", err.Error(), personID)
+		return err
+	}
+
+	before, err := newPersonSnapshot(beforeRollback)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	after, err := newPersonSnapshot(afterRollback)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	err = insertOutboxEvent(ctx, tx, PersonUpdatedEventType, personID, PersonEventPayload{Before: before, After: after})
+	if err != nil {
+		r.logger.Errorf("%v while adding event to the outbox, args: %v", err.Error(), personID)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Stores the person state as the next person revision and adds it to the persons changes log as part of tx
+func insertPersonRevision(ctx context.Context, tx *sqlx.Tx, action string, p Person) error {
+	id, err := strconv.Atoi(p.ID)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("INSERT INTO %[1]s (person_id, revision, action, fullname_ru, fullname_en, "+
+		"birthday, sex, photo_id, changed_by) SELECT $1, COALESCE(MAX(revision), 0) + 1, $2, $3, $4, $5, $6, $7, $8 "+
+		"FROM %[1]s WHERE person_id=$1", personRevisionsTableName)
+
+	_, err = tx.ExecContext(ctx, query, id, action, p.FullnameRU, p.FullnameEN,
+		p.Birthday, p.Sex, p.PhotoID, getActorFromContext(ctx))
+	if err != nil {
+		return err
+	}
+	return insertPersonChange(ctx, tx, action, p)
+}
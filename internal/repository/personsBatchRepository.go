@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/translit"
+	"github.com/jmoiron/sqlx"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	batchItemSavepoint = "batch_item"
+	// Key of the advisory lock, that is held while persons are created, so concurrent requests can't create the same person
+	createPersonsLockKey = 7318402253
+)
+
+func (r *personsRepository) CreatePersons(ctx context.Context,
+	persons []CreatePersonParam, allOrNothing bool) ([]int32, [][]int32, []error, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.CreatePersons")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, nil, nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", int64(createPersonsLockKey))
+	if err != nil {
+		r.logger.Error(err)
+		return nil, nil, nil, err
+	}
+
+	toSearch := make([]SearchPersonParam, len(persons))
+	for i, person := range persons {
+		toSearch[i] = SearchPersonParam{
+			FullnameRU: person.FullnameRU,
+			FullnameEN: person.FullnameEN,
+			Birthday:   person.Birthday,
+			Sex:        person.Sex,
+		}
+	}
+	existingIDs, err := r.findExistingPersons(ctx, tx, toSearch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ids := make([]int32, len(persons))
+	itemsErrs := make([]error, len(persons))
+	if allOrNothing {
+		for i := range persons {
+			if len(existingIDs[i]) > 0 {
+				return ids, existingIDs, itemsErrs, nil
+			}
+		}
+	}
+	for i, person := range persons {
+		if len(existingIDs[i]) > 0 {
+			continue
+		}
+		if allOrNothing {
+			ids[i], err = r.insertPerson(ctx, tx, person)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			continue
+		}
+
+		err = r.execInSavepoint(ctx, tx, func() (err error) {
+			ids[i], err = r.insertPerson(ctx, tx, person)
+			return
+		})
+		var itemErr *batchItemError
+		if errors.As(err, &itemErr) {
+			itemsErrs[i] = itemErr.err
+		} else if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return nil, nil, nil, err
+	}
+	return ids, existingIDs, itemsErrs, nil
+}
+
+func (r *personsRepository) FindExistingPersons(ctx context.Context, persons []SearchPersonParam) ([][]int32, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.FindExistingPersons")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	existingIDs, err := r.findExistingPersons(ctx, r.db, persons)
+	return existingIDs, err
+}
+
+// Searches persons, that are equal to each of the persons, with one query, persons are equal as in IsPersonAlreadyExists
+func (r *personsRepository) findExistingPersons(ctx context.Context,
+	q sqlx.QueryerContext, persons []SearchPersonParam) ([][]int32, error) {
+	var (
+		indexes, ruIndexes, enIndexes []int32
+		ruNames, enNames              []string
+		birthdays, sexes              []string
+	)
+	for i, person := range persons {
+		for _, name := range translit.Variants(person.FullnameRU) {
+			ruIndexes, ruNames = append(ruIndexes, int32(i)), append(ruNames, name)
+		}
+		if person.FullnameEN != "" {
+			for _, name := range translit.Variants(person.FullnameEN) {
+				enIndexes, enNames = append(enIndexes, int32(i)), append(enNames, name)
+			}
+		}
+		// empty birthday and sex match any
+		var birthday string
+		if !person.Birthday.IsZero() {
+			birthday = person.Birthday.Format("2006-01-02")
+		}
+		indexes, birthdays, sexes = append(indexes, int32(i)), append(birthdays, birthday), append(sexes, person.Sex)
+	}
+
+	query := fmt.Sprintf("WITH searched AS (SELECT * FROM unnest($1::INT[], $2::TEXT[], $3::TEXT[]) AS s(idx, birthday, sex)), "+
+		"ru AS (SELECT * FROM unnest($4::INT[], $5::TEXT[]) AS ru(idx, name)), "+
+		"en AS (SELECT * FROM unnest($6::INT[], $7::TEXT[]) AS en(idx, name)) "+
+		"SELECT DISTINCT s.idx, p.id FROM searched s JOIN ru ON ru.idx=s.idx "+
+		"JOIN %s p ON LOWER(p.fullname_ru)=ru.name OR LOWER(p.fullname_en)=ru.name "+
+		"WHERE p.deleted_at IS NULL AND (s.birthday='' OR p.birthday=NULLIF(s.birthday, '')::DATE) "+
+		"AND (s.sex='' OR p.sex=s.sex) AND (NOT EXISTS (SELECT 1 FROM en WHERE en.idx=s.idx) "+
+		"OR EXISTS (SELECT 1 FROM en WHERE en.idx=s.idx AND (LOWER(p.fullname_ru)=en.name OR LOWER(p.fullname_en)=en.name))) "+
+		"ORDER BY s.idx, p.id", personsTableName)
+	args := []any{indexes, birthdays, sexes, ruIndexes, ruNames, enIndexes, enNames}
+
+	var found []struct {
+		Index int32 `db:"idx"`
+		ID    int32 `db:"id"`
+	}
+	err := sqlx.SelectContext(ctx, q, &found, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, args)
+		return nil, err
+	}
+
+	existingIDs := make([][]int32, len(persons))
+	for _, f := range found {
+		existingIDs[f.Index] = append(existingIDs[f.Index], f.ID)
+	}
+	return existingIDs, nil
+}
+
+func (r *personsRepository) UpdatePersons(ctx context.Context,
+	updates []PersonUpdate, allOrNothing bool) ([]error, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.UpdatePersons")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	itemsErrs := make([]error, len(updates))
+	failed := false
+	for i, update := range updates {
+		if len(update.Fields) == 0 {
+			itemsErrs[i] = ErrInvalidArgument
+			failed = true
+			continue
+		}
+		setStatement, args, err := r.getSetStatement(update.Param, []any{update.ID}, update.Fields)
+		if err != nil {
+			itemsErrs[i] = err
+			failed = true
+			continue
+		}
+
+		// all updates are applied even in the all or nothing mode to report all missing persons
+		err = r.execInSavepoint(ctx, tx, func() error {
+			_, err := r.updatePerson(ctx, tx, update.ID, setStatement, args, update.ExpectedVersion)
+			return err
+		})
+		var itemErr *batchItemError
+		if errors.As(err, &itemErr) {
+			itemsErrs[i] = itemErr.err
+		} else if err != nil {
+			return nil, err
+		}
+		failed = failed || itemsErrs[i] != nil
+	}
+	if failed && allOrNothing {
+		return itemsErrs, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return nil, err
+	}
+	return itemsErrs, nil
+}
+
+// Returned by execInSavepoint, if exec failed and the savepoint is rolled back, so the transaction can be continued
+type batchItemError struct {
+	err error
+}
+
+func (e *batchItemError) Error() string {
+	return e.err.Error()
+}
+
+func (e *batchItemError) Unwrap() error {
+	return e.err
+}
+
+// Calls exec inside the savepoint, that is rolled back if exec fails, so the transaction can be continued.
+// Returns *batchItemError with the exec error, other errors are savepoint errors, the transaction can't be continued after them
+func (r *personsRepository) execInSavepoint(ctx context.Context, tx *sqlx.Tx, exec func() error) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+batchItemSavepoint); err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	execErr := exec()
+	var err error
+	if execErr != nil {
+		_, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+batchItemSavepoint)
+	} else {
+		_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT "+batchItemSavepoint)
+	}
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	if execErr != nil {
+		return &batchItemError{err: execErr}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+)
+
+type personsChangesRepository struct {
+	db     *sqlx.DB
+	logger *logrus.Logger
+}
+
+const (
+	personsChangesTableName           = "persons_changes"
+	personsChangesCompactionTableName = "persons_changes_compaction"
+)
+
+func NewPersonsChangesRepository(db *sqlx.DB, logger *logrus.Logger) *personsChangesRepository {
+	return &personsChangesRepository{db: db, logger: logger}
+}
+
+func (r *personsChangesRepository) GetPersonsChanges(ctx context.Context,
+	afterRevision int64, limit int32) ([]PersonChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsChangesRepository.GetPersonsChanges")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil && !errors.Is(err, ErrChangesCompacted))
+
+	// the compacted revision and the changes are read from the same snapshot, so the changes,
+	// removed after the compacted revision is read, are still returned
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		r.logger.Error(err)
+		return []PersonChange{}, err
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("SELECT compacted_revision FROM %s", personsChangesCompactionTableName)
+	var compactedRevision int64
+	if err = tx.GetContext(ctx, &compactedRevision, query); err != nil {
+		r.logger.Errorf("%v query: %s", err.Error(), query)
+		return []PersonChange{}, err
+	}
+	if afterRevision < compactedRevision {
+		err = ErrChangesCompacted
+		return []PersonChange{}, err
+	}
+
+	query = fmt.Sprintf("SELECT revision, action, changed_at, person_id AS id, fullname_ru, fullname_en, birthday, "+
+		"sex, photo_id, deleted_at, version, created_at, updated_at, created_by, updated_by FROM %s "+
+		"WHERE revision > $1 ORDER BY revision LIMIT %d", personsChangesTableName, limit)
+
+	var changes []PersonChange
+	err = tx.SelectContext(ctx, &changes, query, afterRevision)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, afterRevision)
+		return []PersonChange{}, err
+	}
+	return changes, nil
+}
+
+func (r *personsChangesRepository) GetLastPersonsChangeRevision(ctx context.Context) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsChangesRepository.GetLastPersonsChangeRevision")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	// all changes can be removed, then the last revision is the compacted one
+	query := fmt.Sprintf("SELECT GREATEST((SELECT MAX(revision) FROM %s), compacted_revision) FROM %s",
+		personsChangesTableName, personsChangesCompactionTableName)
+
+	var revision int64
+	err = r.db.GetContext(ctx, &revision, query)
+	if err != nil {
+		r.logger.Errorf("%v query: %s", err.Error(), query)
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (r *personsChangesRepository) DeleteOldPersonsChanges(ctx context.Context,
+	changedBefore time.Time, limit int32) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsChangesRepository.DeleteOldPersonsChanges")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	// the compacted revision is updated in the same statement, so watchers never miss the removed changes
+	query := fmt.Sprintf("WITH deleted AS (DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE changed_at < $1 "+
+		"AND revision IS NOT NULL ORDER BY revision LIMIT %[3]d) RETURNING revision), "+
+		"compacted AS (UPDATE %[2]s SET compacted_revision=GREATEST(compacted_revision, (SELECT MAX(revision) FROM deleted))) "+
+		"SELECT COUNT(*) FROM deleted", personsChangesTableName, personsChangesCompactionTableName, limit)
+
+	var deleted int64
+	err = r.db.GetContext(ctx, &deleted, query, changedBefore)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, changedBefore)
+		return 0, err
+	}
+	return deleted, nil
+}
+
+// Adds the change with the person state to the changes log as part of tx, the revision is assigned on the commit
+func insertPersonChange(ctx context.Context, tx *sqlx.Tx, action string, p Person) error {
+	id, err := strconv.Atoi(p.ID)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (action, person_id, fullname_ru, fullname_en, birthday, sex, photo_id, "+
+		"deleted_at, version, created_at, updated_at, created_by, updated_by) "+
+		"VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)", personsChangesTableName)
+	_, err = tx.ExecContext(ctx, query, action, id, p.FullnameRU, p.FullnameEN, p.Birthday, p.Sex, p.PhotoID,
+		p.DeletedAt, p.Version, p.CreatedAt, p.UpdatedAt, p.CreatedBy, p.UpdatedBy)
+	return err
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/translit"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (r *personsRepository) FindPersonsWithSimilarNames(ctx context.Context,
+	names []string, excludeID int32, limit int32) ([]ScoredPerson, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.FindPersonsWithSimilarNames")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	var variants []string
+	for _, name := range names {
+		if name != "" {
+			variants = append(variants, translit.Variants(name)...)
+		}
+	}
+	if len(variants) == 0 {
+		return []ScoredPerson{}, ErrInvalidArgument
+	}
+
+	// % operator uses pg_trgm.similarity_threshold, which is 0.3 by default
+	condition, score, args := getTrgmStatements(variants, "%", "similarity")
+	args = append(args, excludeID)
+	query := fmt.Sprintf("SELECT *, %s AS score FROM %s WHERE %s AND id<>$%d ORDER BY score DESC, id LIMIT %d",
+		score, personsTableName, condition, len(args), limit)
+
+	var persons []ScoredPerson
+	err = r.db.SelectContext(ctx, &persons, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, args)
+		return []ScoredPerson{}, err
+	}
+	return persons, nil
+}
+
+func (r *personsRepository) FindPersonsWithSimilarNamesBatch(ctx context.Context,
+	names [][]string, limit int32) ([][]ScoredPerson, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.FindPersonsWithSimilarNamesBatch")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	var (
+		indexes  []int32
+		variants []string
+	)
+	for i, itemNames := range names {
+		for _, name := range itemNames {
+			if name == "" {
+				continue
+			}
+			for _, variant := range translit.Variants(name) {
+				indexes, variants = append(indexes, int32(i)), append(variants, variant)
+			}
+		}
+	}
+	candidates := make([][]ScoredPerson, len(names))
+	if len(variants) == 0 {
+		return candidates, nil
+	}
+
+	// candidates of each item are searched as in FindPersonsWithSimilarNames, the limit is applied per item
+	query := fmt.Sprintf("WITH searched AS (SELECT * FROM unnest($1::INT[], $2::TEXT[]) AS s(idx, name)) "+
+		"SELECT i.idx, c.* FROM (SELECT DISTINCT idx FROM searched) i CROSS JOIN LATERAL ("+
+		"SELECT p.*, MAX(GREATEST(similarity(s.name, LOWER(p.fullname_ru)), "+
+		"COALESCE(similarity(s.name, LOWER(p.fullname_en)), 0))) AS score "+
+		"FROM searched s JOIN %s p ON s.name %% LOWER(p.fullname_ru) OR s.name %% LOWER(p.fullname_en) "+
+		"WHERE s.idx=i.idx AND p.deleted_at IS NULL GROUP BY p.id ORDER BY score DESC, p.id LIMIT %d) c "+
+		"ORDER BY i.idx, c.score DESC, c.id", personsTableName, limit)
+	args := []any{indexes, variants}
+
+	var found []struct {
+		Index int32 `db:"idx"`
+		ScoredPerson
+	}
+	err = r.db.SelectContext(ctx, &found, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, args)
+		return nil, err
+	}
+
+	for _, f := range found {
+		candidates[f.Index] = append(candidates[f.Index], f.ScoredPerson)
+	}
+	return candidates, nil
+}
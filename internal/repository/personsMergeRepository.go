@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	mergedRedirectReason = "merged"
+)
+
+func (r *personsRepository) MergePersons(ctx context.Context,
+	sourceIDs []int32, targetID int32, resolution MergeFieldResolution) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.MergePersons")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	ids := append([]int32{targetID}, sourceIDs...)
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id=ANY($1) AND deleted_at IS NULL ORDER BY id FOR UPDATE",
+		personsTableName)
+	var persons []Person
+	err = tx.SelectContext(ctx, &persons, query, ids)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, ids)
+		return err
+	} else if len(persons) != len(ids) {
+		return ErrNotFound
+	}
+
+	personsByID := make(map[int32]Person, len(persons))
+	for _, p := range persons {
+		id, err := strconv.Atoi(p.ID)
+		if err != nil {
+			r.logger.Error(err)
+			return err
+		}
+		personsByID[int32(id)] = p
+	}
+
+	beforeMerge := personsByID[targetID]
+	merged, err := getMergedPerson(beforeMerge, personsByID, resolution)
+	if err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf("UPDATE %s SET fullname_ru=$2, fullname_en=$3, birthday=$4, sex=$5, photo_id=$6, "+
+		"%s WHERE id=$1 RETURNING *", personsTableName, getTouchStatement(7))
+	var afterMerge Person
+	err = tx.GetContext(ctx, &afterMerge, query, targetID, merged.FullnameRU, merged.FullnameEN,
+		merged.Birthday, merged.Sex, merged.PhotoID, getActorFromContext(ctx))
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, targetID)
+		return err
+	}
+
+	err = insertPersonRevision(ctx, tx, PersonMergedAction, afterMerge)
+	if err != nil {
+		r.logger.Errorf("%v while adding person revision, args: %v", err.Error(), targetID)
+		return err
+	}
+	for _, id := range sourceIDs {
+		err = insertPersonRevision(ctx, tx, PersonMergedIntoAction, personsByID[id])
+		if err != nil {
+			r.logger.Errorf("%v while adding person revision, args: %v", err.Error(), id)
+			return err
+		}
+	}
+
+	query = fmt.Sprintf("DELETE FROM %s WHERE id=ANY($1)", personsTableName)
+	_, err = tx.ExecContext(ctx, query, sourceIDs)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, sourceIDs)
+		return err
+	}
+
+	err = insertPersonsRedirects(ctx, tx, sourceIDs, targetID, mergedRedirectReason)
+	if err != nil {
+		r.logger.Errorf("%v while adding redirects, args: %v %v", err.Error(), sourceIDs, targetID)
+		return err
+	}
+
+	before, err := newPersonSnapshot(beforeMerge)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	after, err := newPersonSnapshot(afterMerge)
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+
+	err = insertOutboxEvent(ctx, tx, PersonUpdatedEventType, targetID, PersonEventPayload{Before: before, After: after})
+	if err != nil {
+		r.logger.Errorf("%v while adding event to the outbox, args: %v", err.Error(), targetID)
+		return err
+	}
+	err = insertOutboxEvent(ctx, tx, PersonMergedEventType, targetID,
+		PersonMergedPayload{SourceIDs: sourceIDs, Target: after})
+	if err != nil {
+		r.logger.Errorf("%v while adding event to the outbox, args: %v", err.Error(), targetID)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Returns target person with the fields values taken from the persons chosen by resolution
+func getMergedPerson(target Person, personsByID map[int32]Person, resolution MergeFieldResolution) (Person, error) {
+	pick := func(id int32) (Person, error) {
+		if id == 0 {
+			return target, nil
+		}
+		p, ok := personsByID[id]
+		if !ok {
+			return Person{}, ErrInvalidArgument
+		}
+		return p, nil
+	}
+
+	merged := target
+	p, err := pick(resolution.FullnameRU)
+	if err != nil {
+		return Person{}, err
+	}
+	merged.FullnameRU = p.FullnameRU
+
+	if p, err = pick(resolution.FullnameEN); err != nil {
+		return Person{}, err
+	}
+	merged.FullnameEN = p.FullnameEN
+
+	if p, err = pick(resolution.Birthday); err != nil {
+		return Person{}, err
+	}
+	merged.Birthday = p.Birthday
+
+	if p, err = pick(resolution.Sex); err != nil {
+		return Person{}, err
+	}
+	merged.Sex = p.Sex
+
+	if p, err = pick(resolution.PhotoID); err != nil {
+		return Person{}, err
+	}
+	merged.PhotoID = p.PhotoID
+	return merged, nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	personsRedirectsTableName = "persons_redirects"
+)
+
+// Replaces redirected ids in the $1 array with the ids, they were redirected to
+var resolveRedirectsStatement = fmt.Sprintf("SELECT COALESCE(r.to_id, ids.id) FROM UNNEST($1::INT[]) ids(id) "+
+	"LEFT JOIN %s r ON r.from_id=ids.id", personsRedirectsTableName)
+
+func (r *personsRepository) GetPersonsRedirects(ctx context.Context, ids []int32) (map[int32]int32, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetPersonsRedirects")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("SELECT r.from_id, r.to_id FROM %s r JOIN %s p ON p.id=r.to_id "+
+		"WHERE r.from_id=ANY($1) AND p.deleted_at IS NULL", personsRedirectsTableName, personsTableName)
+
+	var redirects []struct {
+		FromID int32 `db:"from_id"`
+		ToID   int32 `db:"to_id"`
+	}
+	err = r.db.SelectContext(ctx, &redirects, query, ids)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, ids)
+		return map[int32]int32{}, err
+	}
+
+	res := make(map[int32]int32, len(redirects))
+	for _, redirect := range redirects {
+		res[redirect.FromID] = redirect.ToID
+	}
+	return res, nil
+}
+
+// Redirects fromIDs to toID as part of tx, existing redirects to fromIDs are redirected to toID too
+func insertPersonsRedirects(ctx context.Context, tx *sqlx.Tx, fromIDs []int32, toID int32, reason string) error {
+	query := fmt.Sprintf("UPDATE %s SET to_id=$2 WHERE to_id=ANY($1)", personsRedirectsTableName)
+	_, err := tx.ExecContext(ctx, query, fromIDs, toID)
+	if err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf("INSERT INTO %s (from_id, to_id, reason) SELECT UNNEST($1::INT[]), $2, $3 "+
+		"ON CONFLICT (from_id) DO UPDATE SET to_id=EXCLUDED.to_id, reason=EXCLUDED.reason", personsRedirectsTableName)
+	_, err = tx.ExecContext(ctx, query, fromIDs, toID, reason)
+	return err
+}
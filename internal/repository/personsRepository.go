@@ -6,8 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Falokut/admin_movies_persons_service/internal/translit"
 	"github.com/jackc/pgx/v5"
 	stdlib "github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
@@ -22,6 +25,10 @@ type personsRepository struct {
 
 const (
 	personsTableName = "persons"
+
+	exportCursorName = "persons_export_cursor"
+	// Number of the persons fetched from the export cursor at once
+	exportFetchSize = 1000
 )
 
 func NewPersonsRepository(db *sqlx.DB, logger *logrus.Logger) *personsRepository {
@@ -45,18 +52,24 @@ func (r *personsRepository) Shutdown() {
 	r.db.Close()
 }
 
-func (r *personsRepository) GetPersons(ctx context.Context, ids []int32, limit, offset int32) ([]Person, error) {
+func (r *personsRepository) GetPersons(ctx context.Context,
+	ids []int32, filter PersonsFilter, page Page) ([]Person, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetPersons")
 	defer span.Finish()
 
 	var err error
 	defer span.SetTag("error", err != nil)
 
-	query := fmt.Sprintf("SELECT * FROM %s WHERE id=ANY($1) ORDER BY id LIMIT %d OFFSET %d",
-		personsTableName, limit, offset)
+	filterCondition, args := getFilterStatement(filter, []any{ids})
+	pageCondition, pageStatement, args, err := getPageStatements(page, args, "")
+	if err != nil {
+		return []Person{}, err
+	}
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id IN (%s) AND deleted_at IS NULL%s%s %s",
+		personsTableName, resolveRedirectsStatement, filterCondition, pageCondition, pageStatement)
 
 	var persons []Person
-	err = r.db.SelectContext(ctx, &persons, query, ids)
+	err = r.db.SelectContext(ctx, &persons, query, args...)
 	if err != nil {
 		r.logger.Errorf("%v query: %s args: %v ", err.Error(), query, ids)
 		return []Person{}, err
@@ -67,6 +80,28 @@ func (r *personsRepository) GetPersons(ctx context.Context, ids []int32, limit,
 	return persons, nil
 }
 
+func (r *personsRepository) GetPerson(ctx context.Context, id int32) (Person, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetPerson")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id IN (%s) AND deleted_at IS NULL",
+		personsTableName, resolveRedirectsStatement)
+
+	var person Person
+	err = r.db.GetContext(ctx, &person, query, []int32{id})
+	if errors.Is(err, sql.ErrNoRows) {
+		return Person{}, ErrNotFound
+	} else if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, id)
+		return Person{}, err
+	}
+
+	return person, nil
+}
+
 func (r *personsRepository) IsPersonAlreadyExists(ctx context.Context, person SearchPersonParam) (bool, []int32, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.IsPersonAlreadyExists")
 	defer span.Finish()
@@ -74,7 +109,7 @@ func (r *personsRepository) IsPersonAlreadyExists(ctx context.Context, person Se
 	var err error
 	defer span.SetTag("error", err != nil)
 
-	whereStatement, args := r.getWhereStatement(person)
+	whereStatement, args := r.getWhereStatement(person, true)
 	query := fmt.Sprintf("SELECT id FROM %s %s", personsTableName, whereStatement)
 	if len(args) == 0 {
 		return false, []int32{}, ErrInvalidArgument
@@ -91,18 +126,37 @@ func (r *personsRepository) IsPersonAlreadyExists(ctx context.Context, person Se
 	return true, ids, nil
 }
 
-func (r *personsRepository) SearchPersonByName(ctx context.Context, name string, limit, offset int32) ([]Person, error) {
+// The shorter the matched name, the closer it to the searched name
+const searchByNameRelevance = "CASE WHEN LOWER(fullname_ru) LIKE ANY($1) THEN LENGTH(fullname_ru) ELSE LENGTH(fullname_en) END"
+
+const searchByNameCondition = "(LOWER(fullname_ru) LIKE ANY($1) OR LOWER(fullname_en) LIKE ANY($1)) AND deleted_at IS NULL"
+
+// Returns LIKE patterns for the name and its transliterations
+func getSearchByNamePatterns(name string) []string {
+	variants := translit.Variants(name)
+	for i := range variants {
+		variants[i] += "%"
+	}
+	return variants
+}
+
+func (r *personsRepository) SearchPersonByName(ctx context.Context, name string, page Page) ([]Person, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.SearchPersonByName")
 	defer span.Finish()
 
 	var err error
 	defer span.SetTag("error", err != nil)
 
-	query := fmt.Sprintf("SELECT * FROM %s WHERE LOWER(fullname_ru) LIKE($1)"+
-		" OR LOWER(fullname_en) LIKE($1) ORDER BY id LIMIT %d OFFSET %d;", personsTableName, limit, offset)
+	pageCondition, pageStatement, args, err := getPageStatements(page,
+		[]any{getSearchByNamePatterns(name)}, searchByNameRelevance)
+	if err != nil {
+		return []Person{}, err
+	}
+	query := fmt.Sprintf("SELECT * FROM %s WHERE "+searchByNameCondition+"%s %s;",
+		personsTableName, pageCondition, pageStatement)
 
 	var persons []Person
-	err = r.db.SelectContext(ctx, &persons, query, strings.ToLower(name)+"%")
+	err = r.db.SelectContext(ctx, &persons, query, args...)
 	if errors.Is(err, sql.ErrNoRows) {
 		return []Person{}, ErrNotFound
 	} else if err != nil {
@@ -112,19 +166,23 @@ func (r *personsRepository) SearchPersonByName(ctx context.Context, name string,
 	return persons, nil
 }
 
-func (r *personsRepository) SearchPerson(ctx context.Context, person SearchPersonParam, limit, offset int32) ([]Person, error) {
+func (r *personsRepository) SearchPerson(ctx context.Context, person SearchPersonParam, page Page) ([]Person, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.SearchPerson")
 	defer span.Finish()
 
 	var err error
 	defer span.SetTag("error", err != nil && !errors.Is(err, sql.ErrNoRows))
 
-	whereStatement, args := r.getWhereStatement(person)
-	query := fmt.Sprintf("SELECT * FROM %s %s ORDER BY id LIMIT %d OFFSET %d",
-		personsTableName, whereStatement, limit, offset)
+	whereStatement, args := r.getWhereStatement(person, false)
 	if len(args) == 0 {
 		return []Person{}, ErrInvalidArgument
 	}
+	pageCondition, pageStatement, args, err := getPageStatements(page, args, "")
+	if err != nil {
+		return []Person{}, err
+	}
+	query := fmt.Sprintf("SELECT * FROM %s %s%s %s",
+		personsTableName, whereStatement, pageCondition, pageStatement)
 
 	var persons []Person
 	err = r.db.SelectContext(ctx, &persons, query, args...)
@@ -137,17 +195,22 @@ func (r *personsRepository) SearchPerson(ctx context.Context, person SearchPerso
 	return persons, nil
 }
 
-func (r *personsRepository) GetAllPersons(ctx context.Context, limit, offset int32) ([]Person, error) {
+func (r *personsRepository) GetAllPersons(ctx context.Context, filter PersonsFilter, page Page) ([]Person, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetAllPersons")
 	defer span.Finish()
 
 	var err error
 	defer span.SetTag("error", err != nil)
-	query := fmt.Sprintf("SELECT * FROM %s ORDER BY id LIMIT %d OFFSET %d",
-		personsTableName, limit, offset)
+	filterCondition, args := getFilterStatement(filter, []any{})
+	pageCondition, pageStatement, args, err := getPageStatements(page, args, "")
+	if err != nil {
+		return []Person{}, err
+	}
+	query := fmt.Sprintf("SELECT * FROM %s WHERE deleted_at IS NULL%s%s %s",
+		personsTableName, filterCondition, pageCondition, pageStatement)
 
 	var persons []Person
-	err = r.db.SelectContext(ctx, &persons, query)
+	err = r.db.SelectContext(ctx, &persons, query, args...)
 	if err != nil {
 		r.logger.Errorf("%v query: %s", err.Error(), query)
 		return []Person{}, err
@@ -158,25 +221,415 @@ func (r *personsRepository) GetAllPersons(ctx context.Context, limit, offset int
 	return persons, nil
 }
 
-func (r *personsRepository) DeletePersons(ctx context.Context, ids []int32) ([]int32, error) {
+func (r *personsRepository) ExportPersons(ctx context.Context,
+	filter PersonsFilter, handle func(Person) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.ExportPersons")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		r.logger.Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	filterCondition, args := getFilterStatement(filter, []any{})
+	query := fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR SELECT * FROM %s WHERE deleted_at IS NULL%s ORDER BY id",
+		exportCursorName, personsTableName, filterCondition)
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, args)
+		return err
+	}
+
+	fetchQuery := fmt.Sprintf("FETCH %d FROM %s", exportFetchSize, exportCursorName)
+	persons := make([]Person, 0, exportFetchSize)
+	for {
+		persons = persons[:0]
+		if err = tx.SelectContext(ctx, &persons, fetchQuery); err != nil {
+			r.logger.Errorf("%v query: %s", err.Error(), fetchQuery)
+			return err
+		}
+		if len(persons) == 0 {
+			return nil
+		}
+
+		for _, p := range persons {
+			if err = handle(p); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (r *personsRepository) GetPersonsCount(ctx context.Context, ids []int32, filter PersonsFilter) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetPersonsCount")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	filterCondition, args := getFilterStatement(filter, []any{ids})
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE id IN (%s) AND deleted_at IS NULL%s",
+		personsTableName, resolveRedirectsStatement, filterCondition)
+
+	var count int64
+	err = r.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, ids)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *personsRepository) GetAllPersonsCount(ctx context.Context, filter PersonsFilter) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.GetAllPersonsCount")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	filterCondition, args := getFilterStatement(filter, []any{})
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE deleted_at IS NULL%s", personsTableName, filterCondition)
+
+	var count int64
+	err = r.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s", err.Error(), query)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *personsRepository) SearchPersonCount(ctx context.Context, person SearchPersonParam) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.SearchPersonCount")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	whereStatement, args := r.getWhereStatement(person, false)
+	if len(args) == 0 {
+		return 0, ErrInvalidArgument
+	}
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", personsTableName, whereStatement)
+
+	var count int64
+	err = r.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, args)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *personsRepository) SearchPersonByNameCount(ctx context.Context, name string) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.SearchPersonByNameCount")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE "+searchByNameCondition, personsTableName)
+
+	var count int64
+	err = r.db.GetContext(ctx, &count, query, getSearchByNamePatterns(name))
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, name)
+		return 0, err
+	}
+	return count, nil
+}
+
+// Min word similarity of the fuzzy search, lower than the pg_trgm default to tolerate typos in short names
+const fuzzySearchThreshold = 0.4
+
+// Returns the condition and the score expression for the name and its transliterations,
+// score is the max word similarity of the person names to the name variants
+func getFuzzySearchStatements(name string) (string, string, []any) {
+	return getTrgmStatements(translit.Variants(name), "<%", "word_similarity")
+}
+
+// Returns the condition, that matches persons with any of the names, and the score expression,
+// score is the max value of the similarity function for the person names and the names
+func getTrgmStatements(names []string, operator, similarity string) (string, string, []any) {
+	conditions := make([]string, 0, len(names))
+	similarities := make([]string, 0, len(names))
+	args := make([]any, 0, len(names))
+	for i, name := range names {
+		conditions = append(conditions,
+			fmt.Sprintf("$%[1]d %[2]s LOWER(fullname_ru) OR $%[1]d %[2]s LOWER(fullname_en)", i+1, operator))
+		similarities = append(similarities,
+			fmt.Sprintf("%[2]s($%[1]d, LOWER(fullname_ru)), COALESCE(%[2]s($%[1]d, LOWER(fullname_en)), 0)", i+1, similarity))
+		args = append(args, name)
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ") AND deleted_at IS NULL",
+		"GREATEST(" + strings.Join(similarities, ", ") + ")", args
+}
+
+func (r *personsRepository) FuzzySearchPersonByName(ctx context.Context,
+	name string, page Page) ([]ScoredPerson, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.FuzzySearchPersonByName")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.beginFuzzySearchTx(ctx)
+	if err != nil {
+		r.logger.Error(err)
+		return []ScoredPerson{}, err
+	}
+	defer tx.Rollback()
+
+	condition, score, args := getFuzzySearchStatements(name)
+	query := fmt.Sprintf("SELECT *, %s AS score FROM %s WHERE %s ORDER BY score DESC, id LIMIT %d OFFSET %d",
+		score, personsTableName, condition, page.Limit, page.Offset)
+
+	var persons []ScoredPerson
+	err = tx.SelectContext(ctx, &persons, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, name)
+		return []ScoredPerson{}, err
+	}
+	return persons, nil
+}
+
+func (r *personsRepository) FuzzySearchPersonByNameCount(ctx context.Context, name string) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.FuzzySearchPersonByNameCount")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.beginFuzzySearchTx(ctx)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	condition, _, args := getFuzzySearchStatements(name)
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", personsTableName, condition)
+
+	var count int64
+	err = tx.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, name)
+		return 0, err
+	}
+	return count, nil
+}
+
+// Begins read only tx with the fuzzySearchThreshold set for the <% operator
+func (r *personsRepository) beginFuzzySearchTx(ctx context.Context) (*sqlx.Tx, error) {
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL pg_trgm.word_similarity_threshold = %v", fuzzySearchThreshold))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (r *personsRepository) DeletePersons(ctx context.Context,
+	ids []int32, expectedVersions map[int32]int32) ([]int32, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.DeletePersons")
 	defer span.Finish()
 
 	var err error
 	defer span.SetTag("error", err != nil)
 
-	query := fmt.Sprintf("DELETE FROM %s WHERE id=ANY($1) RETURNING id", personsTableName)
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=NOW(), %s "+
+		"WHERE id=ANY($1) AND deleted_at IS NULL RETURNING *", personsTableName, getTouchStatement(2))
+	return r.changePersonsDeletionMark(ctx, PersonDeletedAction, query, ids, expectedVersions)
+}
 
-	var deletedIDs = make([]int32, len(ids))
-	err = r.db.SelectContext(ctx, &deletedIDs, query, ids)
-	if errors.Is(err, sql.ErrNoRows) {
-		return []int32{}, ErrNotFound
-	} else if err != nil {
+func (r *personsRepository) RestorePersons(ctx context.Context, ids []int32) ([]int32, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.RestorePersons")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=NULL, %s "+
+		"WHERE id=ANY($1) AND deleted_at IS NOT NULL RETURNING *", personsTableName, getTouchStatement(2))
+	return r.changePersonsDeletionMark(ctx, PersonRestoredAction, query, ids, nil)
+}
+
+// Executes query, that sets or unsets deleted_at, and stores revisions of the changed persons with the action.
+// Query receives ids as $1 and the actor as $2. If expectedVersions isn't empty, versions of the persons are checked before the query
+func (r *personsRepository) changePersonsDeletionMark(ctx context.Context,
+	action, query string, ids []int32, expectedVersions map[int32]int32) ([]int32, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return []int32{}, err
+	}
+	defer tx.Rollback()
+
+	if len(expectedVersions) > 0 {
+		err = checkPersonsVersions(ctx, tx, expectedVersions)
+		if errors.Is(err, ErrVersionMismatch) {
+			return []int32{}, err
+		} else if err != nil {
+			r.logger.Errorf("%v while checking persons versions, args: %v", err.Error(), expectedVersions)
+			return []int32{}, err
+		}
+	}
+
+	var persons []Person
+	err = tx.SelectContext(ctx, &persons, query, ids, getActorFromContext(ctx))
+	if err != nil {
 		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, ids)
 		return []int32{}, err
 	}
 
-	return deletedIDs, nil
+	var changedIDs = make([]int32, 0, len(persons))
+	for _, person := range persons {
+		err = insertPersonRevision(ctx, tx, action, person)
+		if err != nil {
+			r.logger.Errorf("%v while adding person revision, args: %v", err.Error(), person.ID)
+			return []int32{}, err
+		}
+		id, _ := strconv.Atoi(person.ID)
+		changedIDs = append(changedIDs, int32(id))
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return []int32{}, err
+	}
+	return changedIDs, nil
+}
+
+func (r *personsRepository) ReemitPersonsEvents(ctx context.Context, ids []int32) ([]int32, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.ReemitPersonsEvents")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return []int32{}, err
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id=ANY($1) AND deleted_at IS NULL ORDER BY id", personsTableName)
+	var persons []Person
+	err = tx.SelectContext(ctx, &persons, query, ids)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, ids)
+		return []int32{}, err
+	}
+
+	var reemittedIDs = make([]int32, 0, len(persons))
+	for _, person := range persons {
+		snapshot, err := newPersonSnapshot(person)
+		if err != nil {
+			r.logger.Error(err)
+			return []int32{}, err
+		}
+
+		err = insertOutboxEvent(ctx, tx, PersonUpdatedEventType, snapshot.ID,
+			PersonEventPayload{Before: snapshot, After: snapshot, Snapshot: true})
+		if err != nil {
+			r.logger.Errorf("%v while adding event to the outbox, args: %v", err.Error(), snapshot.ID)
+			return []int32{}, err
+		}
+		reemittedIDs = append(reemittedIDs, snapshot.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return []int32{}, err
+	}
+	return reemittedIDs, nil
+}
+
+// Locks the persons and returns ErrVersionMismatch if any of them has the version different from the expected
+func checkPersonsVersions(ctx context.Context, tx *sqlx.Tx, expectedVersions map[int32]int32) error {
+	ids := make([]int32, 0, len(expectedVersions))
+	for id := range expectedVersions {
+		ids = append(ids, id)
+	}
+
+	query := fmt.Sprintf("SELECT id, version FROM %s WHERE id=ANY($1) ORDER BY id FOR UPDATE", personsTableName)
+	rows, err := tx.QueryxContext(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, version int32
+		if err = rows.Scan(&id, &version); err != nil {
+			return err
+		}
+		if expectedVersions[id] != version {
+			return ErrVersionMismatch
+		}
+	}
+	return rows.Err()
+}
+
+func (r *personsRepository) PurgeDeletedPersons(ctx context.Context,
+	deletedBefore time.Time, limit int32) ([]int32, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.PurgeDeletedPersons")
+	defer span.Finish()
+
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return []int32{}, err
+	}
+	defer tx.Rollback()
+
+	query := fmt.Sprintf("DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE deleted_at < $1 "+
+		"ORDER BY deleted_at LIMIT %[2]d FOR UPDATE SKIP LOCKED) RETURNING *", personsTableName, limit)
+
+	var purged []Person
+	err = tx.SelectContext(ctx, &purged, query, deletedBefore)
+	if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, deletedBefore)
+		return []int32{}, err
+	}
+
+	purgedIDs := make([]int32, 0, len(purged))
+	for _, p := range purged {
+		// the removal is logged as the deletion, so the changes log shows, that the person doesn't exist anymore
+		err = insertPersonChange(ctx, tx, PersonDeletedAction, p)
+		if err != nil {
+			r.logger.Errorf("%v while adding person change, args: %v", err.Error(), p.ID)
+			return []int32{}, err
+		}
+		id, _ := strconv.Atoi(p.ID)
+		purgedIDs = append(purgedIDs, int32(id))
+	}
+
+	err = insertOutboxEvents(ctx, tx, PersonDeletedEventType, purgedIDs)
+	if err != nil {
+		r.logger.Errorf("%v while adding events to the outbox, args: %v", err.Error(), purgedIDs)
+		return []int32{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return []int32{}, err
+	}
+	return purgedIDs, nil
 }
 
 func (r *personsRepository) IsPersonsExists(ctx context.Context, ids []int32) ([]int32, bool, error) {
@@ -186,7 +639,7 @@ func (r *personsRepository) IsPersonsExists(ctx context.Context, ids []int32) ([
 	var err error
 	defer span.SetTag("error", err != nil)
 
-	query := fmt.Sprintf("SELECT id FROM %s WHERE id=ANY($1);",
+	query := fmt.Sprintf("SELECT id FROM %s WHERE id=ANY($1) AND deleted_at IS NULL;",
 		personsTableName)
 
 	var foundIDs []int32
@@ -199,23 +652,84 @@ func (r *personsRepository) IsPersonsExists(ctx context.Context, ids []int32) ([
 	return foundIDs, len(ids) == len(foundIDs), nil
 }
 
-func (r *personsRepository) CreatePerson(ctx context.Context, person CreatePersonParam) (int32, error) {
+func (r *personsRepository) CreatePerson(ctx context.Context, person CreatePersonParam) (int32, []int32, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.CreatePerson")
 	defer span.Finish()
 
 	var err error
 	defer span.SetTag("error", err != nil)
 
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, nil, err
+	}
+	defer tx.Rollback()
+
+	// the same lock as in CreatePersons, so the person can't be created concurrently by the batch or another request
+	_, err = tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", int64(createPersonsLockKey))
+	if err != nil {
+		r.logger.Error(err)
+		return 0, nil, err
+	}
+
+	existingIDs, err := r.findExistingPersons(ctx, tx, []SearchPersonParam{{
+		FullnameRU: person.FullnameRU,
+		FullnameEN: person.FullnameEN,
+		Birthday:   person.Birthday,
+		Sex:        person.Sex,
+	}})
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(existingIDs[0]) > 0 {
+		return 0, existingIDs[0], nil
+	}
+
+	id, err := r.insertPerson(ctx, tx, person)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return 0, nil, err
+	}
+	return id, nil, nil
+}
+
+// Inserts person with its revision and person_created outbox event, returns id of the created person
+func (r *personsRepository) insertPerson(ctx context.Context, tx *sqlx.Tx, person CreatePersonParam) (int32, error) {
 	args, fields, values := r.getInsertStatement(person)
-	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES(%s) RETURNING id", personsTableName, fields, values)
+	args = append(args, getActorFromContext(ctx))
+	query := fmt.Sprintf("INSERT INTO %s (%s, created_by, updated_by) VALUES(%s, $%[4]d, $%[4]d) RETURNING *",
+		personsTableName, fields, values, len(args))
 
-	var id int32
-	err = r.db.GetContext(ctx, &id, query, args...)
+	var created Person
+	err := tx.GetContext(ctx, &created, query, args...)
 	if err != nil {
 		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, args)
 		return 0, err
 	}
-	return id, nil
+
+	err = insertPersonRevision(ctx, tx, PersonCreatedAction, created)
+	if err != nil {
+		r.logger.Errorf("%v while adding person revision, args: %v", err.Error(), created.ID)
+		return 0, err
+	}
+
+	after, err := newPersonSnapshot(created)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+
+	err = insertOutboxEvent(ctx, tx, PersonCreatedEventType, after.ID, PersonEventPayload{After: after})
+	if err != nil {
+		r.logger.Errorf("%v while adding event to the outbox, args: %v", err.Error(), after.ID)
+		return 0, err
+	}
+	return after.ID, nil
 }
 
 func (r *personsRepository) IsPersonWithIDExist(ctx context.Context, id int32) (bool, error) {
@@ -225,7 +739,7 @@ func (r *personsRepository) IsPersonWithIDExist(ctx context.Context, id int32) (
 	var err error
 	defer span.SetTag("error", err != nil && !errors.Is(err, sql.ErrNoRows))
 
-	query := fmt.Sprintf("SELECT id FROM %s WHERE id=$1 LIMIT 1;", personsTableName)
+	query := fmt.Sprintf("SELECT id FROM %s WHERE id=$1 AND deleted_at IS NULL LIMIT 1;", personsTableName)
 
 	err = r.db.GetContext(ctx, &id, query, id)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -239,28 +753,93 @@ func (r *personsRepository) IsPersonWithIDExist(ctx context.Context, id int32) (
 }
 
 func (r *personsRepository) UpdatePerson(ctx context.Context, id int32,
-	toUpdate UpdatePersonParam, excludeDefaultValues bool) error {
+	toUpdate UpdatePersonParam, fields []PersonField, expectedVersion int32) (int32, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "personsRepository.UpdatePerson")
 	defer span.Finish()
 
 	var err error
 	defer span.SetTag("error", err != nil)
 
-	setStatement, args := r.getSetStatement(toUpdate, []any{id}, excludeDefaultValues)
-	if len(args) == 1 {
-		return nil
+	if len(fields) == 0 {
+		return 0, nil
+	}
+	setStatement, args, err := r.getSetStatement(toUpdate, []any{id}, fields)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	version, err := r.updatePerson(ctx, tx, id, setStatement, args, expectedVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(err)
+		return 0, err
 	}
+	return version, nil
+}
 
-	query := fmt.Sprintf("UPDATE %s %s WHERE id=$1", personsTableName, setStatement)
-	_, err = r.db.ExecContext(ctx, query, args...)
+// Updates not deleted person with its revision and person_updated outbox event, returns the new person version
+func (r *personsRepository) updatePerson(ctx context.Context, tx *sqlx.Tx,
+	id int32, setStatement string, args []any, expectedVersion int32) (int32, error) {
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id=$1 AND deleted_at IS NULL FOR UPDATE", personsTableName)
+	var beforeUpdate Person
+	err := tx.GetContext(ctx, &beforeUpdate, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrNotFound
+	} else if err != nil {
+		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, id)
+		return 0, err
+	} else if expectedVersion > 0 && beforeUpdate.Version != expectedVersion {
+		return 0, ErrVersionMismatch
+	}
+
+	args = append(args, getActorFromContext(ctx))
+	query = fmt.Sprintf("UPDATE %s %s, %s WHERE id=$1 RETURNING *",
+		personsTableName, setStatement, getTouchStatement(len(args)))
+	var afterUpdate Person
+	err = tx.GetContext(ctx, &afterUpdate, query, args...)
 	if err != nil {
 		r.logger.Errorf("%v query: %s args: %v", err.Error(), query, id)
-		return err
+		return 0, err
+	}
+
+	err = insertPersonRevision(ctx, tx, PersonUpdatedAction, afterUpdate)
+	if err != nil {
+		r.logger.Errorf("%v while adding person revision, args: %v", err.Error(), id)
+		return 0, err
+	}
+
+	before, err := newPersonSnapshot(beforeUpdate)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
+	}
+	after, err := newPersonSnapshot(afterUpdate)
+	if err != nil {
+		r.logger.Error(err)
+		return 0, err
 	}
-	return nil
+
+	err = insertOutboxEvent(ctx, tx, PersonUpdatedEventType, id, PersonEventPayload{Before: before, After: after})
+	if err != nil {
+		r.logger.Errorf("%v while adding event to the outbox, args: %v", err.Error(), id)
+		return 0, err
+	}
+	return afterUpdate.Version, nil
 }
 
-func (r *personsRepository) getWhereStatement(person SearchPersonParam) (string, []any) {
+// If transliterateNames is true, names are compared case insensitive with the names in both columns
+// and with their transliterations
+func (r *personsRepository) getWhereStatement(person SearchPersonParam, transliterateNames bool) (string, []any) {
 	rv := reflect.ValueOf(person)
 	rt := rv.Type()
 
@@ -275,34 +854,154 @@ func (r *personsRepository) getWhereStatement(person SearchPersonParam) (string,
 			continue
 		}
 
-		statements = append(statements, fmt.Sprintf("%s=$%d", rt.Field(i).Tag.Get("db"), index))
-		args = append(args, v)
+		column := rt.Field(i).Tag.Get("db")
+		if name, ok := v.(string); ok && transliterateNames && (column == "fullname_ru" || column == "fullname_en") {
+			statements = append(statements,
+				fmt.Sprintf("(LOWER(fullname_ru)=ANY($%[1]d) OR LOWER(fullname_en)=ANY($%[1]d))", index))
+			args = append(args, translit.Variants(name))
+		} else {
+			statements = append(statements, fmt.Sprintf("%s=$%d", column, index))
+			args = append(args, v)
+		}
 		index++
 	}
+	statements = append(statements, "deleted_at IS NULL")
 
 	return " WHERE " + strings.Join(statements, " AND "), args
 }
 
-func (r *personsRepository) getSetStatement(toUpdate UpdatePersonParam, args []any, excludeDefault bool) (string, []any) {
-	rv := reflect.ValueOf(toUpdate)
-	rt := rv.Type()
-
-	index := len(args) + 1
-	statements := make([]string, 0, rt.NumField())
+var personsOrderColumns = map[PersonsOrder]string{
+	OrderByID:         "id",
+	OrderByFullnameRU: "fullname_ru",
+	OrderByFullnameEN: "fullname_en",
+	OrderByBirthday:   "birthday",
+	OrderByCreatedAt:  "created_at",
+	OrderByUpdatedAt:  "updated_at",
+}
 
-	for i := 0; i < rt.NumField(); i++ {
-		v := rv.Field(i).Interface()
+// Returns conditions for the not zero filter fields, conditions start with AND
+func getFilterStatement(filter PersonsFilter, args []any) (string, []any) {
+	conditions := []struct {
+		statement string
+		value     time.Time
+	}{
+		{"created_at>=", filter.CreatedAfter},
+		{"created_at<", filter.CreatedBefore},
+		{"updated_at>=", filter.UpdatedAfter},
+		{"updated_at<", filter.UpdatedBefore},
+	}
 
-		if excludeDefault && isDefaultValue(v) {
+	var statement strings.Builder
+	for _, condition := range conditions {
+		if condition.value.IsZero() {
 			continue
 		}
+		args = append(args, condition.value)
+		fmt.Fprintf(&statement, " AND %s$%d", condition.statement, len(args))
+	}
 
-		statements = append(statements, fmt.Sprintf("%s=$%d", rt.Field(i).Tag.Get("db"), index))
-		args = append(args, v)
+	if filter.HasPhoto.Valid && filter.HasPhoto.Bool {
+		statement.WriteString(" AND COALESCE(photo_id,'')<>''")
+	} else if filter.HasPhoto.Valid {
+		statement.WriteString(" AND COALESCE(photo_id,'')=''")
+	}
+	if filter.Sex != "" {
+		args = append(args, filter.Sex)
+		fmt.Fprintf(&statement, " AND sex=$%d", len(args))
+	}
+	return statement.String(), args
+}
+
+// Returns statement, that increments the person version and sets updated_at and updated_by,
+// the actor from ctx must be passed as the argument with the actorIndex
+func getTouchStatement(actorIndex int) string {
+	return fmt.Sprintf("version=version+1, updated_at=NOW(), updated_by=$%d", actorIndex)
+}
+
+// Returns the keyset pagination condition, which starts with AND, if it's used, ORDER BY and LIMIT statements.
+// relevance is the sql expression, persons with the lower value are more relevant, empty if relevance isn't supported.
+func getPageStatements(page Page, args []any, relevance string) (string, string, []any, error) {
+	if page.OrderBy == OrderByRelevance {
+		if relevance == "" || page.AfterID > 0 {
+			return "", "", nil, ErrInvalidArgument
+		}
+		return "", fmt.Sprintf("ORDER BY %s, id LIMIT %d OFFSET %d", relevance, page.Limit, page.Offset), args, nil
+	}
+
+	column := "id"
+	if page.OrderBy != "" {
+		var ok bool
+		if column, ok = personsOrderColumns[page.OrderBy]; !ok {
+			return "", "", nil, ErrInvalidArgument
+		}
+	}
+	direction, comparison := "ASC", ">"
+	if page.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if column == "id" {
+		orderStatement := fmt.Sprintf("ORDER BY id %s", direction)
+		if page.AfterID > 0 {
+			args = append(args, page.AfterID)
+			return fmt.Sprintf(" AND id%s$%d", comparison, len(args)),
+				fmt.Sprintf("%s LIMIT %d", orderStatement, page.Limit), args, nil
+		}
+		return "", fmt.Sprintf("%s LIMIT %d OFFSET %d", orderStatement, page.Limit, page.Offset), args, nil
+	}
+
+	// id is used to make the order stable for persons with the same values
+	orderStatement := fmt.Sprintf("ORDER BY %s %s NULLS LAST, id", column, direction)
+	if page.AfterID <= 0 {
+		return "", fmt.Sprintf("%s LIMIT %d OFFSET %d", orderStatement, page.Limit, page.Offset), args, nil
+	}
+
+	args = append(args, page.AfterID)
+	afterIDIndex := len(args)
+	if page.AfterValue == nil {
+		return fmt.Sprintf(" AND (%s IS NULL AND id>$%d)", column, afterIDIndex),
+			fmt.Sprintf("%s LIMIT %d", orderStatement, page.Limit), args, nil
+	}
+
+	args = append(args, page.AfterValue)
+	return fmt.Sprintf(" AND (%[1]s%[2]s$%[3]d OR (%[1]s=$%[3]d AND id>$%[4]d) OR %[1]s IS NULL)",
+			column, comparison, len(args), afterIDIndex),
+		fmt.Sprintf("%s LIMIT %d", orderStatement, page.Limit), args, nil
+}
+
+// Returns SET statement for the fields, fields with the default values are set to null,
+// returns ErrInvalidArgument if field is unknown or fullname_ru is empty
+func (r *personsRepository) getSetStatement(toUpdate UpdatePersonParam,
+	args []any, fields []PersonField) (string, []any, error) {
+	index := len(args) + 1
+	statements := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		var value any
+		switch field {
+		case FullnameRUField:
+			if toUpdate.FullnameRU == "" {
+				return "", nil, ErrInvalidArgument
+			}
+			value = toUpdate.FullnameRU
+		case FullnameENField:
+			value = sql.NullString{String: toUpdate.FullnameEN, Valid: toUpdate.FullnameEN != ""}
+		case BirthdayField:
+			value = sql.NullTime{Time: toUpdate.Birthday, Valid: !toUpdate.Birthday.IsZero()}
+		case SexField:
+			value = sql.NullString{String: toUpdate.Sex, Valid: toUpdate.Sex != ""}
+		case PhotoIDField:
+			value = sql.NullString{String: toUpdate.PhotoID, Valid: toUpdate.PhotoID != ""}
+		default:
+			return "", nil, ErrInvalidArgument
+		}
+
+		statements = append(statements, fmt.Sprintf("%s=$%d", field, index))
+		args = append(args, value)
 		index++
 	}
 
-	return " SET " + strings.Join(statements, ", "), args
+	return " SET " + strings.Join(statements, ", "), args, nil
 }
 
 func (r *personsRepository) getInsertStatement(person CreatePersonParam) ([]any, string, string) {
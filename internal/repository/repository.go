@@ -24,6 +24,91 @@ type Person struct {
 	Birthday   sql.NullTime   `db:"birthday"`
 	Sex        sql.NullString `db:"sex"`
 	PhotoID    sql.NullString `db:"photo_id"`
+	DeletedAt  sql.NullTime   `db:"deleted_at"`
+	Version    int32          `db:"version"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  time.Time      `db:"updated_at"`
+	// actors, that created and last changed the person
+	CreatedBy sql.NullString `db:"created_by"`
+	UpdatedBy sql.NullString `db:"updated_by"`
+}
+
+type ScoredPerson struct {
+	Person
+	// similarity of the person name to the searched name in range [0;1]
+	Score float32 `db:"score"`
+}
+
+const (
+	PersonCreatedAction    = "created"
+	PersonUpdatedAction    = "updated"
+	PersonDeletedAction    = "deleted"
+	PersonRestoredAction   = "restored"
+	PersonRolledBackAction = "rolled_back"
+	// the person is the target of the merge
+	PersonMergedAction = "merged"
+	// the person is the source of the merge, it's removed after the merge
+	PersonMergedIntoAction = "merged_into"
+)
+
+type PersonRevision struct {
+	PersonID   int32          `db:"person_id"`
+	Revision   int32          `db:"revision"`
+	Action     string         `db:"action"`
+	FullnameRU string         `db:"fullname_ru"`
+	FullnameEN sql.NullString `db:"fullname_en"`
+	Birthday   sql.NullTime   `db:"birthday"`
+	Sex        sql.NullString `db:"sex"`
+	PhotoID    sql.NullString `db:"photo_id"`
+	ChangedBy  sql.NullString `db:"changed_by"`
+	ChangedAt  time.Time      `db:"changed_at"`
+}
+
+// Change of the person from the changes log, Person is the state of the person after the change
+type PersonChange struct {
+	Revision  int64     `db:"revision"`
+	Action    string    `db:"action"`
+	ChangedAt time.Time `db:"changed_at"`
+	Person
+}
+
+// Persons ordering, only these values can be used in the queries
+type PersonsOrder string
+
+const (
+	OrderByID         PersonsOrder = "id"
+	OrderByFullnameRU PersonsOrder = "fullname_ru"
+	OrderByFullnameEN PersonsOrder = "fullname_en"
+	OrderByBirthday   PersonsOrder = "birthday"
+	OrderByCreatedAt  PersonsOrder = "created_at"
+	OrderByUpdatedAt  PersonsOrder = "updated_at"
+	// Supported only by SearchPersonByName, most relevant persons go first, Desc is ignored
+	OrderByRelevance PersonsOrder = "relevance"
+)
+
+// Persons page, if AfterID > 0, keyset pagination is used and Offset is ignored
+type Page struct {
+	Limit  int32
+	Offset int32
+	// id of the last person on the previous page
+	AfterID int32
+	// OrderBy value of the last person on the previous page, nil if the value is null
+	AfterValue any
+
+	// if empty, OrderByID is used
+	OrderBy PersonsOrder
+	Desc    bool
+}
+
+// Persons filter, fields with the zero values aren't used, ranges include After and exclude Before
+type PersonsFilter struct {
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	// if valid, only persons with or without photo are returned
+	HasPhoto sql.NullBool
+	Sex      string
 }
 
 type UpdatePersonParam struct {
@@ -34,6 +119,28 @@ type UpdatePersonParam struct {
 	PhotoID    string    `db:"photo_id"`
 }
 
+// Person columns, that can be updated
+type PersonField string
+
+const (
+	FullnameRUField PersonField = "fullname_ru"
+	FullnameENField PersonField = "fullname_en"
+	BirthdayField   PersonField = "birthday"
+	SexField        PersonField = "sex"
+	PhotoIDField    PersonField = "photo_id"
+)
+
+var AllPersonFields = []PersonField{FullnameRUField, FullnameENField, BirthdayField, SexField, PhotoIDField}
+
+// Partial person update, only Fields are updated
+type PersonUpdate struct {
+	ID     int32
+	Param  UpdatePersonParam
+	Fields []PersonField
+	// if > 0, person is updated only if its version is equal to ExpectedVersion
+	ExpectedVersion int32
+}
+
 type SearchPersonParam struct {
 	FullnameRU string    `db:"fullname_ru"`
 	FullnameEN string    `db:"fullname_en"`
@@ -49,18 +156,200 @@ type CreatePersonParam struct {
 	PhotoID    string    `db:"photo_id"`
 }
 
+const (
+	PersonCreatedEventType = "person_created"
+	PersonUpdatedEventType = "person_updated"
+	PersonDeletedEventType = "person_deleted"
+	PersonMergedEventType  = "person_merged"
+)
+
+type OutboxEvent struct {
+	ID        int64  `db:"id"`
+	EventID   string `db:"event_id"`
+	EventType string `db:"event_type"`
+	PersonID  int32  `db:"person_id"`
+	Payload   []byte `db:"payload"`
+	// json encoded opentracing text map of the span, that added event
+	TraceContext []byte    `db:"trace_context"`
+	CreatedAt    time.Time `db:"created_at"`
+	Attempts     int32     `db:"attempts"`
+}
+
+// Person state stored in the outbox event payload
+type PersonSnapshot struct {
+	ID         int32      `json:"id"`
+	FullnameRU string     `json:"fullname_ru"`
+	FullnameEN string     `json:"fullname_en,omitempty"`
+	Birthday   *time.Time `json:"birthday,omitempty"`
+	Sex        string     `json:"sex,omitempty"`
+	PhotoID    string     `json:"photo_id,omitempty"`
+}
+
+// Payload of the person_created and person_updated outbox events,
+// Before is nil for the person_created event
+type PersonEventPayload struct {
+	Before *PersonSnapshot `json:"before,omitempty"`
+	After  *PersonSnapshot `json:"after,omitempty"`
+	// true for the re-emitted person_updated events, the person isn't changed
+	Snapshot bool `json:"snapshot,omitempty"`
+}
+
+// Payload of the person_merged outbox event
+type PersonMergedPayload struct {
+	SourceIDs []int32         `json:"source_ids"`
+	Target    *PersonSnapshot `json:"target"`
+}
+
+// Ids of the persons, whose field values are used by the merge, 0 means the target person value
+type MergeFieldResolution struct {
+	FullnameRU int32
+	FullnameEN int32
+	Birthday   int32
+	Sex        int32
+	PhotoID    int32
+}
+
+// Idempotency key of the request, keys are unique per method
+type IdempotencyKey struct {
+	Key    string
+	Method string
+	// keys are unique per actor, empty for the requests without the actor
+	Actor string
+	// hash of the request, repeated requests with the key must have the same hash
+	RequestHash []byte
+}
+
+type IdempotencyRecord struct {
+	Key         string `db:"key"`
+	Method      string `db:"method"`
+	Actor       string `db:"actor"`
+	RequestHash []byte `db:"request_hash"`
+	// nil while the request is in progress
+	Response  []byte    `db:"response"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
 var ErrNotFound = errors.New("entity not found")
 var ErrInvalidArgument = errors.New("invalid input data")
+var ErrVersionMismatch = errors.New("person version mismatch")
+var ErrChangesCompacted = errors.New("changes after the revision are removed")
+
+type actorCtxKey struct{}
+
+// Returns the copy of the ctx with the actor, actor is stored as the author of the persons changes
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorCtxKey{}, actor)
+}
+
+func getActorFromContext(ctx context.Context) sql.NullString {
+	actor, ok := ctx.Value(actorCtxKey{}).(string)
+	return sql.NullString{String: actor, Valid: ok && actor != ""}
+}
 
 type PersonsRepository interface {
-	GetPersons(ctx context.Context, ids []int32, limit, offset int32) ([]Person, error)
-	GetAllPersons(ctx context.Context, limit, offset int32) ([]Person, error)
-	DeletePersons(ctx context.Context, ids []int32) ([]int32, error)
-	SearchPerson(ctx context.Context, person SearchPersonParam, limit, offset int32) ([]Person, error)
-	UpdatePerson(ctx context.Context, id int32, toUpdate UpdatePersonParam, excludeDefaultValues bool) error
-	CreatePerson(ctx context.Context, person CreatePersonParam) (int32, error)
+	// Redirected ids are replaced with the ids, they were redirected to
+	GetPersons(ctx context.Context, ids []int32, filter PersonsFilter, page Page) ([]Person, error)
+	GetAllPersons(ctx context.Context, filter PersonsFilter, page Page) ([]Person, error)
+	// Reads not deleted persons ordered by id with the cursor and passes them to handle,
+	// all persons are read from the same snapshot
+	ExportPersons(ctx context.Context, filter PersonsFilter, handle func(Person) error) error
+	// Returns not deleted person, redirected id is replaced with the id, it was redirected to
+	GetPerson(ctx context.Context, id int32) (Person, error)
+	// Marks persons as deleted, returns ids of the marked persons.
+	// If expectedVersions isn't empty, persons are marked only if their versions are equal to the expected,
+	// otherwise ErrVersionMismatch is returned
+	DeletePersons(ctx context.Context, ids []int32, expectedVersions map[int32]int32) ([]int32, error)
+	// Unmarks deleted persons, returns ids of the restored persons
+	RestorePersons(ctx context.Context, ids []int32) ([]int32, error)
+	// Adds person_updated snapshot events with the same before and after to the outbox for the not deleted persons,
+	// returns ids of the persons, whose events are added
+	ReemitPersonsEvents(ctx context.Context, ids []int32) ([]int32, error)
+	// Removes up to limit persons, that were marked as deleted before deletedBefore, the deleted change is added
+	// for each removed person, returns ids of the removed persons
+	PurgeDeletedPersons(ctx context.Context, deletedBefore time.Time, limit int32) ([]int32, error)
+	SearchPerson(ctx context.Context, person SearchPersonParam, page Page) ([]Person, error)
+	// Updates only fields of the person, fields with the default values are set to null.
+	// If expectedVersion > 0 and the person version differs, ErrVersionMismatch is returned.
+	// Returns the new version of the person, 0 if fields are empty
+	UpdatePerson(ctx context.Context, id int32, toUpdate UpdatePersonParam,
+		fields []PersonField, expectedVersion int32) (int32, error)
+	// Applies updates in one transaction, returns errors of the updates in the same order as updates,
+	// ErrNotFound for the missing persons, ErrVersionMismatch for the persons with unexpected versions. If allOrNothing is true, nothing is updated if any update failed
+	UpdatePersons(ctx context.Context, updates []PersonUpdate, allOrNothing bool) ([]error, error)
+	// Creates the person, if there are no equal persons, returns id of the created person or ids of the existing persons,
+	// that are equal to the person, the existence is checked under the same lock as in CreatePersons
+	CreatePerson(ctx context.Context, person CreatePersonParam) (int32, []int32, error)
+	// Creates persons in one transaction, returns ids of the created persons, ids of the existing persons,
+	// that are equal to the persons, and insert errors in the same order as persons.
+	// Persons, that already exist, aren't created. If allOrNothing is true, nothing is created if any person exists,
+	// and on the first failed insert the error is returned, otherwise failed persons are skipped, their ids are 0
+	CreatePersons(ctx context.Context, persons []CreatePersonParam, allOrNothing bool) ([]int32, [][]int32, []error, error)
 	IsPersonWithIDExist(ctx context.Context, id int32) (bool, error)
 	IsPersonAlreadyExists(ctx context.Context, person SearchPersonParam) (bool, []int32, error)
+	// Returns ids of the not deleted persons, that are equal to each of the persons, in the same order as persons
+	FindExistingPersons(ctx context.Context, persons []SearchPersonParam) ([][]int32, error)
 	IsPersonsExists(ctx context.Context, ids []int32) ([]int32, bool, error)
-	SearchPersonByName(ctx context.Context, name string, limit, offset int32) ([]Person, error)
+	SearchPersonByName(ctx context.Context, name string, page Page) ([]Person, error)
+	// Searches persons with the words similar to the name in any position of the names,
+	// persons are ordered by the score, only Limit and Offset of the page are used
+	FuzzySearchPersonByName(ctx context.Context, name string, page Page) ([]ScoredPerson, error)
+
+	// Returns number of not deleted persons with ids, redirected ids are replaced with the ids, they were redirected to
+	GetPersonsCount(ctx context.Context, ids []int32, filter PersonsFilter) (int64, error)
+	GetAllPersonsCount(ctx context.Context, filter PersonsFilter) (int64, error)
+	SearchPersonCount(ctx context.Context, person SearchPersonParam) (int64, error)
+	SearchPersonByNameCount(ctx context.Context, name string) (int64, error)
+	FuzzySearchPersonByNameCount(ctx context.Context, name string) (int64, error)
+
+	// Returns up to limit persons, whose names are similar to any of the names or their transliterations,
+	// ordered by the names similarity, person with excludeID is skipped
+	FindPersonsWithSimilarNames(ctx context.Context, names []string, excludeID int32, limit int32) ([]ScoredPerson, error)
+	// Searches persons with similar names for each of the names lists with one query, returns up to limit persons
+	// for each list in the same order as names, persons are ordered as in FindPersonsWithSimilarNames
+	FindPersonsWithSimilarNamesBatch(ctx context.Context, names [][]string, limit int32) ([][]ScoredPerson, error)
+
+	// Copies fields chosen by resolution into the target person, removes the source persons
+	// and redirects their ids to the target person
+	MergePersons(ctx context.Context, sourceIDs []int32, targetID int32, resolution MergeFieldResolution) error
+	// Returns ids of the merged or renumbered persons to the ids of the not deleted persons, they were redirected to
+	GetPersonsRedirects(ctx context.Context, ids []int32) (map[int32]int32, error)
+
+	// Returns person revisions starting from the latest
+	GetPersonRevisions(ctx context.Context, personID int32, limit, offset int32) ([]PersonRevision, error)
+	GetPersonRevision(ctx context.Context, personID int32, revision int32) (PersonRevision, error)
+	// Sets person fields to the values from the revision
+	RollbackPerson(ctx context.Context, personID int32, revision int32) error
+}
+
+type OutboxRepository interface {
+	// Locks up to limit unsent events and passes them to handle in order of their creation.
+	// Events for which handle returned nil are marked as sent, processing stops on the first failed event,
+	// unless it failed maxAttempts times, then it's marked as failed and isn't handled anymore.
+	// Returns the number of sent and failed events.
+	HandleUnsentEvents(ctx context.Context, limit, maxAttempts int32,
+		handle func(ctx context.Context, event OutboxEvent) error) (int, error)
+}
+
+type IdempotencyRepository interface {
+	// Reserves the key for the request, returns true if the key is reserved.
+	// Keys created before expiredBefore and keys without response created before pendingBefore are reserved again,
+	// otherwise the existing record is returned.
+	ReserveIdempotencyKey(ctx context.Context, key IdempotencyKey,
+		expiredBefore, pendingBefore time.Time) (IdempotencyRecord, bool, error)
+	// Stores the response of the request with the reserved key
+	SaveIdempotentResponse(ctx context.Context, key IdempotencyKey, response []byte) error
+	// Removes the reserved key without response, so the request can be retried
+	ReleaseIdempotencyKey(ctx context.Context, key IdempotencyKey) error
+	// Removes up to limit keys created before createdBefore, returns the number of the removed keys
+	DeleteExpiredIdempotencyKeys(ctx context.Context, createdBefore time.Time, limit int32) (int64, error)
+}
+
+type PersonsChangesRepository interface {
+	// Returns up to limit changes with the revisions greater than afterRevision ordered by the revision,
+	// ErrChangesCompacted if any of the changes after afterRevision is removed
+	GetPersonsChanges(ctx context.Context, afterRevision int64, limit int32) ([]PersonChange, error)
+	// Returns the revision of the last change, including the removed changes, 0 if there were no changes
+	GetLastPersonsChangeRevision(ctx context.Context) (int64, error)
+	// Removes up to limit changes made before changedBefore, returns the number of the removed changes
+	DeleteOldPersonsChanges(ctx context.Context, changedBefore time.Time, limit int32) (int64, error)
 }
@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/Falokut/admin_movies_persons_service/internal/translit"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxBatchCreatePersons = 1000
+	// Number of the streamed persons, that are created in one transaction
+	streamBatchSize = 100
+)
+
+type batchCreateStatus = movies_persons_service.BatchCreatePersonResult_Status
+
+func (s *MoviesPersonsService) BatchCreatePersons(ctx context.Context,
+	in *movies_persons_service.BatchCreatePersonsRequest) (*movies_persons_service.BatchCreatePersonsResponse, error) {
+	return withIdempotency(ctx, s, "BatchCreatePersons", in,
+		func() (*movies_persons_service.BatchCreatePersonsResponse, error) {
+			return s.batchCreatePersons(ctx, in)
+		})
+}
+
+func (s *MoviesPersonsService) batchCreatePersons(ctx context.Context,
+	in *movies_persons_service.BatchCreatePersonsRequest) (*movies_persons_service.BatchCreatePersonsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.BatchCreatePersons")
+	defer span.Finish()
+	ctx = withActor(ctx)
+
+	if len(in.Persons) == 0 || len(in.Persons) > maxBatchCreatePersons {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			fmt.Sprintf("persons must contain 1-%d persons", maxBatchCreatePersons))
+	}
+
+	results, err := s.createPersonsBatch(ctx, in.Persons, getBatchIndexes(0, len(in.Persons)),
+		personsBatchOptions{allOrNothing: in.AllOrNothing})
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	var created int32
+	for _, res := range results {
+		if res.Status == movies_persons_service.BatchCreatePersonResult_CREATED {
+			created++
+		}
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return &movies_persons_service.BatchCreatePersonsResponse{Results: results, CreatedCount: created}, nil
+}
+
+func (s *MoviesPersonsService) BatchCreatePersonsStream(
+	stream movies_persons_service.MoviesPersonsServiceV1_BatchCreatePersonsStreamServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "MoviesPersonsService.BatchCreatePersonsStream")
+	defer span.Finish()
+	ctx = withActor(ctx)
+
+	var index int32
+	batch := make([]*movies_persons_service.CreatePersonRequest, 0, streamBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		results, err := s.createPersonsBatch(ctx, batch, getBatchIndexes(index, len(batch)), personsBatchOptions{})
+		if err != nil {
+			return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		}
+		for _, res := range results {
+			if err = stream.Send(res); err != nil {
+				return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+			}
+		}
+		index += int32(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		}
+
+		batch = append(batch, in)
+		if len(batch) == streamBatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+	span.SetTag("grpc.status", codes.OK)
+	return nil
+}
+
+type personsBatchOptions struct {
+	allOrNothing bool
+	// persons are only validated, valid persons get the VALID status
+	dryRun bool
+	// indexes of the persons by their keys, used to find duplicates between the batches, if nil,
+	// duplicates are searched only inside the batch
+	keys map[string]int32
+}
+
+// Validates and creates persons, returns results in the same order as persons, indexes are the indexes of the persons.
+// Error is returned only if the batch can't be processed at all.
+func (s *MoviesPersonsService) createPersonsBatch(ctx context.Context,
+	persons []*movies_persons_service.CreatePersonRequest,
+	indexes []int32, opts personsBatchOptions) ([]*movies_persons_service.BatchCreatePersonResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.createPersonsBatch")
+	defer span.Finish()
+
+	results := make([]*movies_persons_service.BatchCreatePersonResult, len(persons))
+	keys := opts.keys
+	if keys == nil {
+		keys = make(map[string]int32, len(persons))
+	}
+	allOrNothing := opts.allOrNothing
+	for i, in := range persons {
+		results[i] = &movies_persons_service.BatchCreatePersonResult{Index: indexes[i]}
+
+		key := getBatchPersonKey(in)
+		if duplicateOf, ok := keys[key]; ok {
+			setBatchResultError(results[i], movies_persons_service.BatchCreatePersonResult_ALREADY_EXISTS,
+				fmt.Sprintf("person duplicates the person with index %d", duplicateOf))
+		} else {
+			keys[key] = results[i].Index
+			validateBatchPerson(in, results[i])
+		}
+	}
+	s.checkExistingBatchPersons(ctx, persons, results)
+
+	s.checkBatchDuplicates(ctx, persons, results)
+	failed := false
+	for i := range persons {
+		failed = failed || results[i].Status != movies_persons_service.BatchCreatePersonResult_CREATED
+	}
+	if failed && allOrNothing {
+		abortBatch(results)
+		return results, nil
+	}
+	if opts.dryRun {
+		s.validateBatchPhotos(ctx, persons, results)
+		return results, nil
+	}
+
+	params := make([]repository.CreatePersonParam, 0, len(persons))
+	// indexes of the persons in the params
+	paramsIndexes := make([]int, 0, len(persons))
+	for i, in := range persons {
+		if results[i].Status != movies_persons_service.BatchCreatePersonResult_CREATED {
+			continue
+		}
+
+		var photoID string
+		if len(in.Photo) > 0 {
+			var err error
+			photoID, err = s.imagesService.UploadImage(ctx, in.Photo)
+			if err != nil {
+				setBatchResultError(results[i], getBatchStatusFromError(err), status.Convert(err).Message())
+				if allOrNothing {
+					s.deleteBatchPhotos(ctx, params)
+					abortBatch(results)
+					return results, nil
+				}
+				continue
+			}
+		}
+
+		params = append(params, repository.CreatePersonParam{
+			FullnameRU: in.GetFullnameRU(),
+			FullnameEN: in.GetFullnameEN(),
+			Birthday:   getTimeFromTimestamp(in.GetBirthday()),
+			Sex:        in.GetSex(),
+			PhotoID:    photoID,
+		})
+		paramsIndexes = append(paramsIndexes, i)
+	}
+	if len(params) == 0 {
+		return results, nil
+	}
+
+	// persons are checked again inside the transaction, because they can be created by the concurrent batches
+	ids, existingIDs, itemsErrs, err := s.repo.CreatePersons(ctx, params, allOrNothing)
+	if err != nil {
+		s.deleteBatchPhotos(ctx, params)
+		return nil, err
+	}
+
+	exist := false
+	for j, i := range paramsIndexes {
+		if len(existingIDs[j]) > 0 {
+			setBatchResultExists(results[i], existingIDs[j])
+			exist = true
+		}
+	}
+	if exist && allOrNothing {
+		s.deleteBatchPhotos(ctx, params)
+		abortBatch(results)
+		return results, nil
+	}
+
+	for j, i := range paramsIndexes {
+		if len(existingIDs[j]) > 0 {
+			s.deleteBatchPhotos(ctx, params[j:j+1])
+			continue
+		}
+		if itemsErrs[j] != nil {
+			setBatchResultError(results[i], movies_persons_service.BatchCreatePersonResult_FAILED, ErrInternal.Error())
+			s.deleteBatchPhotos(ctx, params[j:j+1])
+			continue
+		}
+		results[i].PersonID = ids[j]
+	}
+	return results, nil
+}
+
+// Sets result status, if person is invalid
+func validateBatchPerson(in *movies_persons_service.CreatePersonRequest,
+	res *movies_persons_service.BatchCreatePersonResult) {
+	if strings.TrimSpace(in.FullnameRU) == "" {
+		setBatchResultError(res, movies_persons_service.BatchCreatePersonResult_INVALID_ARGUMENT,
+			"fullname_ru mustn't be empty")
+	}
+}
+
+// Sets the ALREADY_EXISTS status to the persons, that can be created, but already exist,
+// persons are searched with one query for the batch
+func (s *MoviesPersonsService) checkExistingBatchPersons(ctx context.Context,
+	persons []*movies_persons_service.CreatePersonRequest, results []*movies_persons_service.BatchCreatePersonResult) {
+	toSearch := make([]repository.SearchPersonParam, 0, len(persons))
+	// indexes of the persons in toSearch
+	searchIndexes := make([]int, 0, len(persons))
+	for i, in := range persons {
+		if results[i].Status != movies_persons_service.BatchCreatePersonResult_CREATED {
+			continue
+		}
+		toSearch = append(toSearch, repository.SearchPersonParam{
+			FullnameRU: in.GetFullnameRU(),
+			FullnameEN: in.GetFullnameEN(),
+			Birthday:   getTimeFromTimestamp(in.GetBirthday()),
+			Sex:        in.GetSex(),
+		})
+		searchIndexes = append(searchIndexes, i)
+	}
+	if len(toSearch) == 0 {
+		return
+	}
+
+	existingIDs, err := s.repo.FindExistingPersons(ctx, toSearch)
+	for j, i := range searchIndexes {
+		if err != nil {
+			setBatchResultError(results[i], movies_persons_service.BatchCreatePersonResult_FAILED, ErrInternal.Error())
+		} else if len(existingIDs[j]) > 0 {
+			setBatchResultExists(results[i], existingIDs[j])
+		}
+	}
+}
+
+// Sets the POTENTIAL_DUPLICATES status to the persons, that can be created, if persons with similar names are found,
+// candidates of all persons are searched with one query
+func (s *MoviesPersonsService) checkBatchDuplicates(ctx context.Context,
+	persons []*movies_persons_service.CreatePersonRequest, results []*movies_persons_service.BatchCreatePersonResult) {
+	// indexes of the checked persons in the names
+	checked := make([]int, 0, len(persons))
+	names := make([][]string, 0, len(persons))
+	for i, in := range persons {
+		if results[i].Status != movies_persons_service.BatchCreatePersonResult_CREATED || in.IgnorePotentialDuplicates {
+			continue
+		}
+		checked = append(checked, i)
+		names = append(names, []string{in.GetFullnameRU(), in.GetFullnameEN()})
+	}
+	if len(checked) == 0 {
+		return
+	}
+
+	candidates, err := s.repo.FindPersonsWithSimilarNamesBatch(ctx, names, duplicatesCandidatesLimit)
+	for j, i := range checked {
+		if err != nil {
+			setBatchResultError(results[i], movies_persons_service.BatchCreatePersonResult_FAILED, ErrInternal.Error())
+			continue
+		}
+
+		in := persons[i]
+		duplicates := s.scoreDuplicates(ctx, duplicatesSearchParam{
+			FullnameRU: in.GetFullnameRU(),
+			FullnameEN: in.GetFullnameEN(),
+			Birthday:   getTimeFromTimestamp(in.GetBirthday()),
+			Sex:        in.GetSex(),
+		}, candidates[j], defaultDuplicateMinScore)
+		if len(duplicates) > 0 {
+			setBatchResultError(results[i], movies_persons_service.BatchCreatePersonResult_POTENTIAL_DUPLICATES,
+				"finded potential duplicates of the person, "+
+					"if the person isn't one of them, set ignore_potential_duplicates to create the person")
+			results[i].Duplicates = duplicates
+		}
+	}
+}
+
+// Validates photos of the persons, that can be created, and sets the VALID status to them
+func (s *MoviesPersonsService) validateBatchPhotos(ctx context.Context,
+	persons []*movies_persons_service.CreatePersonRequest, results []*movies_persons_service.BatchCreatePersonResult) {
+	for i, in := range persons {
+		if results[i].Status != movies_persons_service.BatchCreatePersonResult_CREATED {
+			continue
+		}
+		if len(in.Photo) > 0 {
+			if err := s.imagesService.ValidateImage(ctx, in.Photo); err != nil {
+				setBatchResultError(results[i], getBatchStatusFromError(err), status.Convert(err).Message())
+				continue
+			}
+		}
+		results[i].Status = movies_persons_service.BatchCreatePersonResult_VALID
+	}
+}
+
+// Removes uploaded photos of the persons, that weren't created
+func (s *MoviesPersonsService) deleteBatchPhotos(ctx context.Context, params []repository.CreatePersonParam) {
+	for _, p := range params {
+		if p.PhotoID == "" {
+			continue
+		}
+		if err := s.imagesService.DeleteImage(ctx, p.PhotoID); err != nil {
+			s.logger.Errorf("%v while deleting photo %s of the not created person", err, p.PhotoID)
+		}
+	}
+}
+
+// Marks persons, that can be created, as aborted
+func abortBatch(results []*movies_persons_service.BatchCreatePersonResult) {
+	for _, res := range results {
+		if res.Status == movies_persons_service.BatchCreatePersonResult_CREATED {
+			setBatchResultError(res, movies_persons_service.BatchCreatePersonResult_ABORTED,
+				"another person in the batch can't be created")
+		}
+	}
+}
+
+func setBatchResultError(res *movies_persons_service.BatchCreatePersonResult, st batchCreateStatus, msg string) {
+	res.Status = st
+	res.Error = msg
+}
+
+func setBatchResultExists(res *movies_persons_service.BatchCreatePersonResult, existingIDs []int32) {
+	setBatchResultError(res, movies_persons_service.BatchCreatePersonResult_ALREADY_EXISTS,
+		fmt.Sprintf("finded persons with ids: %s", formatSlice(existingIDs)))
+	res.ExistingPersonsIDs = existingIDs
+}
+
+func getBatchStatusFromError(err error) batchCreateStatus {
+	if status.Code(err) == codes.InvalidArgument {
+		return movies_persons_service.BatchCreatePersonResult_INVALID_ARGUMENT
+	}
+	return movies_persons_service.BatchCreatePersonResult_FAILED
+}
+
+// Returns n consecutive indexes starting from first
+func getBatchIndexes(first int32, n int) []int32 {
+	indexes := make([]int32, n)
+	for i := range indexes {
+		indexes[i] = first + int32(i)
+	}
+	return indexes
+}
+
+// Returns key, that is equal for the persons with the same names and birthday
+func getBatchPersonKey(in *movies_persons_service.CreatePersonRequest) string {
+	var birthday string
+	if in.Birthday != nil {
+		birthday = getTimeFromTimestamp(in.Birthday).Format("2006-01-02")
+	}
+	return strings.Join([]string{translit.Normalize(in.FullnameRU),
+		translit.Normalize(in.GetFullnameEN()), birthday}, "|")
+}
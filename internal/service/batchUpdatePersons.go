@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxBatchUpdatePersons = 1000
+)
+
+func (s *MoviesPersonsService) BatchUpdatePersons(ctx context.Context,
+	in *movies_persons_service.BatchUpdatePersonsRequest) (*movies_persons_service.BatchUpdatePersonsResponse, error) {
+	return withIdempotency(ctx, s, "BatchUpdatePersons", in,
+		func() (*movies_persons_service.BatchUpdatePersonsResponse, error) {
+			return s.batchUpdatePersons(ctx, in)
+		})
+}
+
+func (s *MoviesPersonsService) batchUpdatePersons(ctx context.Context,
+	in *movies_persons_service.BatchUpdatePersonsRequest) (*movies_persons_service.BatchUpdatePersonsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.BatchUpdatePersons")
+	defer span.Finish()
+	ctx = withActor(ctx)
+
+	if len(in.Updates) == 0 || len(in.Updates) > maxBatchUpdatePersons {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			fmt.Sprintf("updates must contain 1-%d updates", maxBatchUpdatePersons))
+	}
+
+	res := &movies_persons_service.BatchUpdatePersonsResponse{}
+	invalidUpdate := func(i int, msg string) {
+		res.InvalidUpdates = append(res.InvalidUpdates, &movies_persons_service.InvalidPersonUpdate{
+			Index: int32(i),
+			ID:    in.Updates[i].ID,
+			Error: msg,
+		})
+	}
+
+	// indexes of the updates by the persons ids
+	ids := make(map[int32]int, len(in.Updates))
+	fields := make([][]repository.PersonField, len(in.Updates))
+	for i, update := range in.Updates {
+		var err error
+		fields[i], err = getUpdateFields(update)
+		if update.ID <= 0 {
+			invalidUpdate(i, "id must be > 0")
+		} else if j, ok := ids[update.ID]; ok {
+			invalidUpdate(i, fmt.Sprintf("person is already updated by the update with index %d", j))
+		} else if err != nil {
+			invalidUpdate(i, err.Error())
+		} else if update.ExpectedVersion != nil && update.GetExpectedVersion() <= 0 {
+			invalidUpdate(i, "expected_version must be > 0")
+		} else if len(fields[i]) == 0 {
+			invalidUpdate(i, "update must contain at least one field")
+		} else {
+			ids[update.ID] = i
+		}
+	}
+	if len(res.InvalidUpdates) > 0 && in.AllOrNothing {
+		span.SetTag("grpc.status", codes.OK)
+		return res, nil
+	}
+
+	updates := make([]repository.PersonUpdate, 0, len(ids))
+	// indexes of the updates in the request
+	updatesIndexes := make([]int, 0, len(ids))
+	for i, update := range in.Updates {
+		if j, ok := ids[update.ID]; !ok || j != i {
+			continue
+		}
+
+		var photoID string
+		if len(update.Photo) > 0 {
+			var err error
+			photoID, err = s.imagesService.UploadImage(ctx, update.Photo)
+			if err != nil {
+				invalidUpdate(i, status.Convert(err).Message())
+				if in.AllOrNothing {
+					s.deleteUpdatesPhotos(ctx, updates)
+					span.SetTag("grpc.status", codes.OK)
+					return res, nil
+				}
+				continue
+			}
+		}
+
+		updates = append(updates, repository.PersonUpdate{
+			ID: update.ID,
+			Param: repository.UpdatePersonParam{
+				FullnameRU: update.GetFullnameRU(),
+				FullnameEN: update.GetFullnameEN(),
+				Birthday:   getTimeFromTimestamp(update.GetBirthday()),
+				Sex:        update.GetSex(),
+				PhotoID:    photoID,
+			},
+			Fields:          fields[i],
+			ExpectedVersion: update.GetExpectedVersion(),
+		})
+		updatesIndexes = append(updatesIndexes, i)
+	}
+	if len(updates) == 0 {
+		span.SetTag("grpc.status", codes.OK)
+		return res, nil
+	}
+
+	itemsErrs, err := s.repo.UpdatePersons(ctx, updates, in.AllOrNothing)
+	if err != nil {
+		s.deleteUpdatesPhotos(ctx, updates)
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	applied := make([]repository.PersonUpdate, 0, len(updates))
+	notApplied := make([]repository.PersonUpdate, 0, len(updates))
+	for j, i := range updatesIndexes {
+		switch {
+		case errors.Is(itemsErrs[j], repository.ErrNotFound):
+			res.NotFoundIDs = append(res.NotFoundIDs, updates[j].ID)
+		case errors.Is(itemsErrs[j], repository.ErrInvalidArgument):
+			invalidUpdate(i, "fullname_ru can't be null")
+		case errors.Is(itemsErrs[j], repository.ErrVersionMismatch):
+			invalidUpdate(i, ErrVersionMismatch.Error())
+		case itemsErrs[j] != nil:
+			invalidUpdate(i, ErrInternal.Error())
+		default:
+			applied = append(applied, updates[j])
+			continue
+		}
+		notApplied = append(notApplied, updates[j])
+	}
+
+	if in.AllOrNothing && len(notApplied) > 0 {
+		s.deleteUpdatesPhotos(ctx, updates)
+	} else {
+		s.deleteUpdatesPhotos(ctx, notApplied)
+		for _, update := range applied {
+			res.UpdatedIDs = append(res.UpdatedIDs, update.ID)
+		}
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return res, nil
+}
+
+// Removes uploaded photos of the not applied updates
+func (s *MoviesPersonsService) deleteUpdatesPhotos(ctx context.Context, updates []repository.PersonUpdate) {
+	for _, update := range updates {
+		if update.Param.PhotoID == "" {
+			continue
+		}
+		if err := s.imagesService.DeleteImage(ctx, update.Param.PhotoID); err != nil {
+			s.logger.Errorf("%v while deleting photo %s of the not updated person", err, update.Param.PhotoID)
+		}
+	}
+}
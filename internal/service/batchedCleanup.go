@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// Calls removeBatch on start and then every interval until ctx is done.
+// removeBatch removes up to batchSize records and returns their number,
+// it's called again without waiting, while full batches are removed, and isn't retried on error until the next interval
+func runBatchedCleanup(ctx context.Context, interval time.Duration, batchSize int32,
+	removeBatch func(ctx context.Context) (int64, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for ctx.Err() == nil {
+			removed, err := removeBatch(ctx)
+			if err != nil || removed < int64(batchSize) {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
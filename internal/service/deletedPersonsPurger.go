@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type DeletedPersonsPurgerConfig struct {
+	// How long deleted persons can be restored
+	RetentionPeriod time.Duration
+	// Delay between purges
+	Interval time.Duration
+	// Max number of persons removed per query
+	BatchSize int32
+}
+
+func (c DeletedPersonsPurgerConfig) Validate() error {
+	if c.RetentionPeriod <= 0 {
+		return errors.New("retention_period must be positive")
+	}
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("batch_size must be positive")
+	}
+	return nil
+}
+
+// deletedPersonsPurger removes persons, that were marked as deleted more than retention period ago.
+// The person_deleted event is sent only after removing.
+type deletedPersonsPurger struct {
+	cfg    DeletedPersonsPurgerConfig
+	logger *logrus.Logger
+	repo   repository.PersonsRepository
+}
+
+func NewDeletedPersonsPurger(cfg DeletedPersonsPurgerConfig, logger *logrus.Logger,
+	repo repository.PersonsRepository) *deletedPersonsPurger {
+	return &deletedPersonsPurger{
+		cfg:    cfg,
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// Run blocks until ctx is done.
+func (p *deletedPersonsPurger) Run(ctx context.Context) {
+	runBatchedCleanup(ctx, p.cfg.Interval, p.cfg.BatchSize, p.purgeBatch)
+}
+
+func (p *deletedPersonsPurger) purgeBatch(ctx context.Context) (int64, error) {
+	deletedBefore := time.Now().Add(-p.cfg.RetentionPeriod)
+	purgedIDs, err := p.repo.PurgeDeletedPersons(ctx, deletedBefore, p.cfg.BatchSize)
+	if err != nil {
+		p.logger.Errorf("error while purging deleted persons: %v", err)
+		return 0, err
+	}
+	if len(purgedIDs) > 0 {
+		p.logger.Infof("purged deleted persons with ids: %s", formatSlice(purgedIDs))
+	}
+	return int64(len(purgedIDs)), nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// Max number of persons with similar names, that are scored
+	duplicatesCandidatesLimit = 20
+	defaultDuplicateMinScore  = 0.6
+	// Weight of the names similarity in the score, if both birthdays are known
+	nameScoreWeight = 0.7
+	// Birthdays, that differ more, don't increase the score
+	birthdayProximityDays = 30
+	// Score is multiplied by this factor, if sex of the persons differs
+	sexMismatchFactor = 0.5
+)
+
+type duplicatesSearchParam struct {
+	FullnameRU string
+	FullnameEN string
+	// zero if unknown
+	Birthday time.Time
+	Sex      string
+}
+
+func (s *MoviesPersonsService) FindPotentialDuplicates(ctx context.Context,
+	in *movies_persons_service.FindPotentialDuplicatesRequest) (*movies_persons_service.PotentialDuplicates, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.FindPotentialDuplicates")
+	defer span.Finish()
+
+	minScore := float64(defaultDuplicateMinScore)
+	if in.MinScore != nil {
+		minScore = float64(in.GetMinScore())
+	}
+	if minScore < 0 || minScore > 1 {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			"min_score must be in range [0;1]")
+	}
+
+	param := duplicatesSearchParam{
+		FullnameRU: in.GetFullnameRU(),
+		FullnameEN: in.GetFullnameEN(),
+		Birthday:   getTimeFromTimestamp(in.Birthday),
+		Sex:        in.GetSex(),
+	}
+	if in.PersonID > 0 {
+		person, err := s.repo.GetPerson(ctx, in.PersonID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
+		} else if err != nil {
+			return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		}
+		param = duplicatesSearchParam{
+			FullnameRU: person.FullnameRU,
+			FullnameEN: person.FullnameEN.String,
+			Birthday:   person.Birthday.Time,
+			Sex:        person.Sex.String,
+		}
+	} else if param.FullnameRU == "" && param.FullnameEN == "" {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			"person_id, fullname_ru or fullname_en must be specified")
+	}
+
+	duplicates, err := s.findPotentialDuplicates(ctx, param, in.PersonID, minScore)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return &movies_persons_service.PotentialDuplicates{Duplicates: duplicates}, nil
+}
+
+// Returns persons with the score not less than minScore, ordered by the score
+func (s *MoviesPersonsService) findPotentialDuplicates(ctx context.Context, param duplicatesSearchParam,
+	excludeID int32, minScore float64) ([]*movies_persons_service.PotentialDuplicate, error) {
+	candidates, err := s.repo.FindPersonsWithSimilarNames(ctx,
+		[]string{param.FullnameRU, param.FullnameEN}, excludeID, duplicatesCandidatesLimit)
+	if err != nil {
+		return nil, err
+	}
+	return s.scoreDuplicates(ctx, param, candidates, minScore), nil
+}
+
+// Returns candidates with the score not less than minScore, ordered by the score
+func (s *MoviesPersonsService) scoreDuplicates(ctx context.Context, param duplicatesSearchParam,
+	candidates []repository.ScoredPerson, minScore float64) []*movies_persons_service.PotentialDuplicate {
+	duplicates := make([]*movies_persons_service.PotentialDuplicate, 0, len(candidates))
+	for _, candidate := range candidates {
+		score, birthdayDiff := scoreDuplicate(param, candidate)
+		if score < minScore {
+			continue
+		}
+		duplicates = append(duplicates, &movies_persons_service.PotentialDuplicate{
+			Person:           s.convertPerson(ctx, candidate.Person),
+			Score:            float32(score),
+			NameScore:        candidate.Score,
+			BirthdayDiffDays: birthdayDiff,
+		})
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Score > duplicates[j].Score
+	})
+	return duplicates
+}
+
+// Returns the score in range [0;1] and the difference between birthdays in days, if both birthdays are known
+func scoreDuplicate(param duplicatesSearchParam, candidate repository.ScoredPerson) (float64, *int32) {
+	score := float64(candidate.Score)
+
+	var birthdayDiff *int32
+	if !param.Birthday.IsZero() && candidate.Birthday.Valid {
+		days := math.Round(math.Abs(param.Birthday.Sub(candidate.Birthday.Time).Hours() / 24))
+		diff := int32(days)
+		birthdayDiff = &diff
+
+		birthdayScore := math.Max(0, 1-days/birthdayProximityDays)
+		score = nameScoreWeight*score + (1-nameScoreWeight)*birthdayScore
+	}
+
+	if param.Sex != "" && candidate.Sex.String != "" && !strings.EqualFold(param.Sex, candidate.Sex.String) {
+		score *= sexMismatchFactor
+	}
+	return score, birthdayDiff
+}
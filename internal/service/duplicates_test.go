@@ -0,0 +1,90 @@
+package service
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+)
+
+func TestScoreDuplicate(t *testing.T) {
+	birthday := time.Date(1932, 4, 4, 0, 0, 0, 0, time.UTC)
+	candidate := func(score float32, birthday time.Time, sex string) repository.ScoredPerson {
+		return repository.ScoredPerson{
+			Person: repository.Person{
+				Birthday: sql.NullTime{Time: birthday, Valid: !birthday.IsZero()},
+				Sex:      sql.NullString{String: sex, Valid: sex != ""},
+			},
+			Score: score,
+		}
+	}
+	tests := []struct {
+		name      string
+		param     duplicatesSearchParam
+		candidate repository.ScoredPerson
+		want      float64
+		// -1 if the difference is unknown
+		wantDiff int32
+	}{
+		{"only names", duplicatesSearchParam{}, candidate(0.8, time.Time{}, ""), 0.8, -1},
+		{"unknown candidate birthday", duplicatesSearchParam{Birthday: birthday},
+			candidate(0.8, time.Time{}, ""), 0.8, -1},
+		{"same birthday", duplicatesSearchParam{Birthday: birthday},
+			candidate(0.5, birthday, ""), 0.7*0.5 + 0.3, 0},
+		{"birthday differs by half of the proximity", duplicatesSearchParam{Birthday: birthday},
+			candidate(1, birthday.AddDate(0, 0, -15), ""), 0.7 + 0.3*0.5, 15},
+		{"birthday differs by the proximity", duplicatesSearchParam{Birthday: birthday},
+			candidate(1, birthday.AddDate(0, 0, birthdayProximityDays), ""), 0.7, birthdayProximityDays},
+		{"birthday differs by years", duplicatesSearchParam{Birthday: birthday},
+			candidate(1, birthday.AddDate(10, 0, 0), ""), 0.7, 3652},
+		{"same sex in another case", duplicatesSearchParam{Sex: "male"}, candidate(0.8, time.Time{}, "MALE"), 0.8, -1},
+		{"sex mismatch", duplicatesSearchParam{Sex: "male"}, candidate(0.8, time.Time{}, "female"), 0.4, -1},
+		{"unknown candidate sex", duplicatesSearchParam{Sex: "male"}, candidate(0.8, time.Time{}, ""), 0.8, -1},
+		{"sex mismatch with same birthday", duplicatesSearchParam{Birthday: birthday, Sex: "female"},
+			candidate(1, birthday, "male"), 0.5, 0},
+	}
+
+	for _, tt := range tests {
+		got, diff := scoreDuplicate(tt.param, tt.candidate)
+		if math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("%s: got score %v, want %v", tt.name, got, tt.want)
+		}
+		gotDiff := int32(-1)
+		if diff != nil {
+			gotDiff = *diff
+		}
+		if gotDiff != tt.wantDiff {
+			t.Errorf("%s: got birthday diff %d, want %d", tt.name, gotDiff, tt.wantDiff)
+		}
+	}
+}
+
+func TestScoreDuplicateThreshold(t *testing.T) {
+	birthday := time.Date(1932, 4, 4, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		param     duplicatesSearchParam
+		score     float32
+		birthday  time.Time
+		duplicate bool
+	}{
+		{"similar name", duplicatesSearchParam{}, 0.6, time.Time{}, true},
+		{"not similar name", duplicatesSearchParam{}, 0.59, time.Time{}, false},
+		// 0.7*0.45 + 0.3 = 0.615
+		{"not similar name with the same birthday", duplicatesSearchParam{Birthday: birthday}, 0.45, birthday, true},
+		// 0.7*0.8 = 0.56
+		{"similar name with far birthday", duplicatesSearchParam{Birthday: birthday}, 0.8, birthday.AddDate(1, 0, 0), false},
+	}
+
+	for _, tt := range tests {
+		score, _ := scoreDuplicate(tt.param, repository.ScoredPerson{
+			Person: repository.Person{Birthday: sql.NullTime{Time: tt.birthday, Valid: !tt.birthday.IsZero()}},
+			Score:  tt.score,
+		})
+		if duplicate := score >= defaultDuplicateMinScore; duplicate != tt.duplicate {
+			t.Errorf("%s: got score %v, want duplicate %t", tt.name, score, tt.duplicate)
+		}
+	}
+}
@@ -19,6 +19,12 @@ var (
 	ErrInvalidArgument = errors.New("invalid input data")
 	ErrInvalidImage    = errors.New("invalid image")
 	ErrAlreadyExists   = errors.New("already exists")
+	ErrVersionMismatch = errors.New("person version mismatch")
+
+	ErrIdempotencyKeyReused        = errors.New("idempotency key is already used for another request")
+	ErrIdempotentRequestInProgress = errors.New("request with the same idempotency key is in progress")
+	// changes after the requested revision are removed from the changes log
+	ErrRevisionCompacted = errors.New("changes after the revision are removed")
 )
 
 var errorCodes = map[error]codes.Code{
@@ -27,8 +33,13 @@ var errorCodes = map[error]codes.Code{
 	ErrInternal:        codes.Internal,
 	ErrInvalidImage:    codes.InvalidArgument,
 	ErrAlreadyExists:   codes.AlreadyExists,
-	ErrInvalidParam:    codes.InvalidArgument,
-	ErrEmptyParam:      codes.InvalidArgument,
+	ErrVersionMismatch: codes.Aborted,
+
+	ErrIdempotencyKeyReused:        codes.InvalidArgument,
+	ErrIdempotentRequestInProgress: codes.Aborted,
+	ErrRevisionCompacted:           codes.OutOfRange,
+	ErrInvalidParam:                codes.InvalidArgument,
+	ErrEmptyParam:                  codes.InvalidArgument,
 }
 
 type errorHandler struct {
@@ -76,6 +87,29 @@ func (e *errorHandler) createExtendedErrorResponceWithSpan(span opentracing.Span
 }
 
 func (e *errorHandler) createExtendedErrorResponce(err error, developerMessage, userMessage string) error {
+	var details *movies_persons_service.UserErrorMessage
+	if len(userMessage) > 0 {
+		details = &movies_persons_service.UserErrorMessage{Message: userMessage}
+	}
+	return e.createErrorResponceWithDetails(err, developerMessage, details)
+}
+
+func (e *errorHandler) createDuplicatesErrorResponceWithSpan(span opentracing.Span,
+	err error, userMessage string, duplicates []*movies_persons_service.PotentialDuplicate) error {
+	if err == nil {
+		return nil
+	}
+
+	span.SetTag("grpc.status", grpc_errors.GetGrpcCode(err))
+	ext.LogError(span, err)
+	return e.createErrorResponceWithDetails(err, "", &movies_persons_service.UserErrorMessage{
+		Message:    userMessage,
+		Duplicates: duplicates,
+	})
+}
+
+func (e *errorHandler) createErrorResponceWithDetails(err error, developerMessage string,
+	details *movies_persons_service.UserErrorMessage) error {
 	var msg string
 	if developerMessage == "" {
 		msg = err.Error()
@@ -84,8 +118,8 @@ func (e *errorHandler) createExtendedErrorResponce(err error, developerMessage,
 	}
 
 	extErr := status.New(grpc_errors.GetGrpcCode(err), msg)
-	if len(userMessage) > 0 {
-		extErr, _ = extErr.WithDetails(&movies_persons_service.UserErrorMessage{Message: userMessage})
+	if details != nil {
+		extErr, _ = extErr.WithDetails(details)
 		if extErr == nil {
 			e.logger.Error(err)
 			return err
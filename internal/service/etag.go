@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	ifMatchMetadataKey = "if-match"
+	// If-Match header is passed by the REST gateway with this key, if the header isn't in the allowed headers
+	gatewayIfMatchMetadataKey = "grpcgateway-if-match"
+	etagMetadataKey           = "etag"
+)
+
+// Returns the expected person version from the request or from the If-Match header, 0 if it isn't specified
+func getExpectedVersion(ctx context.Context, expected *int32) (int32, error) {
+	if expected != nil {
+		if *expected <= 0 {
+			return 0, fmt.Errorf("%s error: %w", "expected_version must be > 0", ErrInvalidArgument)
+		}
+		return *expected, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	etag := md.Get(ifMatchMetadataKey)
+	if len(etag) == 0 {
+		etag = md.Get(gatewayIfMatchMetadataKey)
+	}
+	if len(etag) == 0 {
+		return 0, nil
+	}
+	return parseETag(etag[0])
+}
+
+// Parses ETag in "<version>" or W/"<version>" format, * matches any version and is parsed as 0
+func parseETag(etag string) (int32, error) {
+	etag = strings.TrimSpace(etag)
+	if etag == "*" {
+		return 0, nil
+	}
+
+	etag = strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+	version, err := strconv.ParseInt(etag, 10, 32)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("%s error: %w", "If-Match must contain the person version", ErrInvalidArgument)
+	}
+	return int32(version), nil
+}
+
+func formatETag(version int32) string {
+	return strconv.Quote(strconv.Itoa(int(version)))
+}
+
+// Sends the person version in the ETag header, does nothing if the version is unknown
+func (s *MoviesPersonsService) setETag(ctx context.Context, version int32) {
+	if version <= 0 {
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(etagMetadataKey, formatETag(version))); err != nil {
+		s.logger.Warnf("%v while setting etag header", err)
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseETag(t *testing.T) {
+	tests := []struct {
+		etag    string
+		want    int32
+		wantErr error
+	}{
+		{`"3"`, 3, nil},
+		{`W/"3"`, 3, nil},
+		{"3", 3, nil},
+		{` "12" `, 12, nil},
+		{"*", 0, nil},
+		{`""`, 0, ErrInvalidArgument},
+		{`"0"`, 0, ErrInvalidArgument},
+		{`"-1"`, 0, ErrInvalidArgument},
+		{`"abc"`, 0, ErrInvalidArgument},
+		{`"3", "4"`, 0, ErrInvalidArgument},
+		{`"2147483648"`, 0, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		got, err := parseETag(tt.etag)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("parseETag(%q): got error %v, want %v", tt.etag, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseETag(%q) = %d, want %d", tt.etag, got, tt.want)
+		}
+	}
+}
+
+func TestFormatETagIsParsed(t *testing.T) {
+	for _, version := range []int32{1, 42, 2147483647} {
+		if got, err := parseETag(formatETag(version)); err != nil || got != version {
+			t.Errorf("parseETag(formatETag(%d)) = %d, %v", version, got, err)
+		}
+	}
+}
+
+func TestGetExpectedVersion(t *testing.T) {
+	requested, invalid := int32(5), int32(0)
+	tests := []struct {
+		name     string
+		expected *int32
+		md       metadata.MD
+		want     int32
+		wantErr  error
+	}{
+		{"not specified", nil, nil, 0, nil},
+		{"expected version", &requested, metadata.Pairs(ifMatchMetadataKey, `"7"`), 5, nil},
+		{"invalid expected version", &invalid, nil, 0, ErrInvalidArgument},
+		{"if-match", nil, metadata.Pairs(ifMatchMetadataKey, `W/"7"`), 7, nil},
+		{"gateway if-match", nil, metadata.Pairs(gatewayIfMatchMetadataKey, `"8"`), 8, nil},
+		{"invalid if-match", nil, metadata.Pairs(ifMatchMetadataKey, "version"), 0, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.md != nil {
+			ctx = metadata.NewIncomingContext(ctx, tt.md)
+		}
+		got, err := getExpectedVersion(ctx, tt.expected)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: got version %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
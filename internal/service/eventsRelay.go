@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/events"
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+)
+
+type EventsRelayConfig struct {
+	// Delay between outbox polls when there are no unsent events
+	PollInterval time.Duration
+	// Max number of events handled per outbox poll
+	BatchSize int32
+	// Max delay between retries after a failed publish
+	MaxBackoff time.Duration
+	// Number of publish attempts, after which the event is marked as failed and skipped
+	MaxAttempts int32
+}
+
+func (c EventsRelayConfig) Validate() error {
+	if c.PollInterval <= 0 {
+		return errors.New("poll_interval must be positive")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("batch_size must be positive")
+	}
+	if c.MaxBackoff < c.PollInterval {
+		return errors.New("max_backoff mustn't be less than poll_interval")
+	}
+	if c.MaxAttempts <= 0 {
+		return errors.New("max_attempts must be positive")
+	}
+	return nil
+}
+
+// eventsRelay publishes events stored in the outbox to the message queue.
+// Event is marked as sent only after successful publishing, so delivery is at-least-once.
+type eventsRelay struct {
+	cfg      EventsRelayConfig
+	logger   *logrus.Logger
+	repo     repository.OutboxRepository
+	eventsMQ events.PersonsEventsMQ
+}
+
+func NewEventsRelay(cfg EventsRelayConfig, logger *logrus.Logger,
+	repo repository.OutboxRepository, eventsMQ events.PersonsEventsMQ) *eventsRelay {
+	return &eventsRelay{
+		cfg:      cfg,
+		logger:   logger,
+		repo:     repo,
+		eventsMQ: eventsMQ,
+	}
+}
+
+// Run blocks until ctx is done.
+func (r *eventsRelay) Run(ctx context.Context) {
+	backoff := r.cfg.PollInterval
+	for {
+		var publishFailed bool
+		handled, err := r.repo.HandleUnsentEvents(ctx, r.cfg.BatchSize, r.cfg.MaxAttempts,
+			func(ctx context.Context, event repository.OutboxEvent) error {
+				err := r.publish(ctx, event)
+				publishFailed = err != nil
+				return err
+			})
+		if err != nil {
+			r.logger.Errorf("error while handling outbox events: %v", err)
+		}
+
+		delay := r.cfg.PollInterval
+		if err != nil || publishFailed {
+			delay = backoff
+			backoff = min(backoff*2, r.cfg.MaxBackoff)
+		} else {
+			backoff = r.cfg.PollInterval
+			if handled == int(r.cfg.BatchSize) {
+				// there may be more unsent events, don't wait
+				delay = 0
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (r *eventsRelay) publish(ctx context.Context, event repository.OutboxEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "eventsRelay.publish")
+	defer span.Finish()
+
+	meta, err := getEventMetadata(event)
+	if err != nil {
+		r.logger.Errorf("error while decoding event %d metadata: %v", event.ID, err)
+		span.SetTag("error", true)
+		return err
+	}
+
+	switch event.EventType {
+	case repository.PersonCreatedEventType, repository.PersonUpdatedEventType:
+		var payload repository.PersonEventPayload
+		if err = json.Unmarshal(event.Payload, &payload); err != nil {
+			break
+		}
+		if event.EventType == repository.PersonCreatedEventType {
+			err = r.eventsMQ.PersonCreated(ctx, meta, convertPersonSnapshot(payload.After))
+		} else if payload.Snapshot {
+			err = r.eventsMQ.PersonSnapshot(ctx, meta, convertPersonSnapshot(payload.After))
+		} else {
+			err = r.eventsMQ.PersonUpdated(ctx, meta,
+				convertPersonSnapshot(payload.Before), convertPersonSnapshot(payload.After))
+		}
+	case repository.PersonDeletedEventType:
+		err = r.eventsMQ.PersonDeleted(ctx, meta, event.PersonID)
+	case repository.PersonMergedEventType:
+		var payload repository.PersonMergedPayload
+		if err = json.Unmarshal(event.Payload, &payload); err != nil {
+			break
+		}
+		err = r.eventsMQ.PersonMerged(ctx, meta, payload.SourceIDs, convertPersonSnapshot(payload.Target))
+	default:
+		err = fmt.Errorf("unknown event type %s", event.EventType)
+	}
+
+	if err != nil {
+		r.logger.Errorf("error while publishing event %d, attempt %d: %v", event.ID, event.Attempts+1, err)
+		span.SetTag("error", true)
+		return err
+	}
+	return nil
+}
+
+func getEventMetadata(event repository.OutboxEvent) (events.EventMetadata, error) {
+	meta := events.EventMetadata{
+		ID:         event.EventID,
+		OccurredAt: event.CreatedAt,
+	}
+	if len(event.TraceContext) > 0 {
+		if err := json.Unmarshal(event.TraceContext, &meta.TraceContext); err != nil {
+			return events.EventMetadata{}, err
+		}
+	}
+	return meta, nil
+}
+
+func convertPersonSnapshot(p *repository.PersonSnapshot) events.Person {
+	if p == nil {
+		return events.Person{}
+	}
+
+	birthday := ""
+	if p.Birthday != nil {
+		birthday = p.Birthday.Format("2006-01-02")
+	}
+	return events.Person{
+		ID:         p.ID,
+		FullnameRU: p.FullnameRU,
+		FullnameEN: p.FullnameEN,
+		Birthday:   birthday,
+		Sex:        p.Sex,
+		PhotoID:    p.PhotoID,
+	}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/parquet"
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// Size of the exported file chunks
+	exportChunkSize = 64 * 1024
+	// Max number of the persons buffered before writing the parquet row group
+	exportParquetRowGroupSize = 10000
+)
+
+// Columns of the exported persons in all formats
+var exportColumns = []parquet.Column{
+	{Name: "id", Type: parquet.Int32},
+	{Name: "fullname_ru", Type: parquet.String},
+	{Name: "fullname_en", Type: parquet.String, Optional: true},
+	{Name: "birthday", Type: parquet.Date, Optional: true},
+	{Name: "sex", Type: parquet.String, Optional: true},
+	{Name: "photo", Type: parquet.String, Optional: true},
+	{Name: "version", Type: parquet.Int32},
+	{Name: "created_at", Type: parquet.Timestamp},
+	{Name: "created_by", Type: parquet.String, Optional: true},
+	{Name: "updated_at", Type: parquet.Timestamp},
+	{Name: "updated_by", Type: parquet.String, Optional: true},
+}
+
+// Person in the jsonl export, the fields are the same as exportColumns
+type exportPersonRecord struct {
+	ID         int32     `json:"id"`
+	FullnameRU string    `json:"fullname_ru"`
+	FullnameEN string    `json:"fullname_en,omitempty"`
+	Birthday   string    `json:"birthday,omitempty"`
+	Sex        string    `json:"sex,omitempty"`
+	Photo      string    `json:"photo,omitempty"`
+	Version    int32     `json:"version"`
+	CreatedAt  time.Time `json:"created_at"`
+	CreatedBy  string    `json:"created_by,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	UpdatedBy  string    `json:"updated_by,omitempty"`
+}
+
+type personsEncoder interface {
+	encode(p exportPersonRecord) error
+	// Writes the buffered persons, the underlying writer isn't closed
+	close() error
+}
+
+func (s *MoviesPersonsService) ExportPersons(in *movies_persons_service.ExportPersonsRequest,
+	stream movies_persons_service.MoviesPersonsServiceV1_ExportPersonsServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "MoviesPersonsService.ExportPersons")
+	defer span.Finish()
+
+	if err := s.WritePersonsExport(ctx, in, exportChunkWriter{stream: stream}); err != nil {
+		return err
+	}
+	span.SetTag("grpc.status", codes.OK)
+	return nil
+}
+
+// Writes persons into w in the requested format, used by the ExportPersons stream and the REST download.
+// The request is validated before anything is written to w
+func (s *MoviesPersonsService) WritePersonsExport(ctx context.Context,
+	in *movies_persons_service.ExportPersonsRequest, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.WritePersonsExport")
+	defer span.Finish()
+
+	if _, ok := movies_persons_service.ExportPersonsRequest_Format_name[int32(in.Format)]; !ok {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, "unsupported format")
+	}
+	filter := repository.PersonsFilter{
+		UpdatedAfter: getTimeFromTimestamp(in.UpdatedSince),
+		Sex:          strings.TrimSpace(in.GetSex()),
+	}
+	if in.HasPhoto != nil {
+		filter.HasPhoto.Valid, filter.HasPhoto.Bool = true, in.GetHasPhoto()
+	}
+
+	buf := bufio.NewWriterSize(w, exportChunkSize)
+	encoder := newPersonsEncoder(in.Format, buf)
+	err := s.repo.ExportPersons(ctx, filter, func(p repository.Person) error {
+		return encoder.encode(s.convertExportPerson(ctx, p))
+	})
+	if err != nil {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+	if err = encoder.close(); err != nil {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+	if err = buf.Flush(); err != nil {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return nil
+}
+
+// Returns content type and file extension of the export format
+func GetExportFormatInfo(format movies_persons_service.ExportPersonsRequest_Format) (string, string) {
+	switch format {
+	case movies_persons_service.ExportPersonsRequest_JSONL:
+		return "application/jsonl", "jsonl"
+	case movies_persons_service.ExportPersonsRequest_PARQUET:
+		return "application/vnd.apache.parquet", "parquet"
+	default:
+		return "text/csv; charset=utf-8", "csv"
+	}
+}
+
+func (s *MoviesPersonsService) convertExportPerson(ctx context.Context, p repository.Person) exportPersonRecord {
+	birthday := ""
+	if p.Birthday.Valid {
+		birthday = p.Birthday.Time.Format("2006-01-02")
+	}
+	id, _ := strconv.Atoi(p.ID)
+	return exportPersonRecord{
+		ID:         int32(id),
+		FullnameRU: p.FullnameRU,
+		FullnameEN: p.FullnameEN.String,
+		Birthday:   birthday,
+		Sex:        p.Sex.String,
+		Photo:      s.imagesService.GetPictureURL(ctx, p.PhotoID.String),
+		Version:    p.Version,
+		CreatedAt:  p.CreatedAt,
+		CreatedBy:  p.CreatedBy.String,
+		UpdatedAt:  p.UpdatedAt,
+		UpdatedBy:  p.UpdatedBy.String,
+	}
+}
+
+// Sends each write as the chunk of the stream
+type exportChunkWriter struct {
+	stream movies_persons_service.MoviesPersonsServiceV1_ExportPersonsServer
+}
+
+func (w exportChunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&movies_persons_service.ExportPersonsChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func newPersonsEncoder(format movies_persons_service.ExportPersonsRequest_Format, w io.Writer) personsEncoder {
+	switch format {
+	case movies_persons_service.ExportPersonsRequest_JSONL:
+		return jsonlPersonsEncoder{encoder: json.NewEncoder(w)}
+	case movies_persons_service.ExportPersonsRequest_PARQUET:
+		return parquetPersonsEncoder{writer: parquet.NewWriter(w, exportColumns, exportParquetRowGroupSize)}
+	default:
+		return &csvPersonsEncoder{writer: csv.NewWriter(w)}
+	}
+}
+
+type csvPersonsEncoder struct {
+	writer      *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvPersonsEncoder) encode(p exportPersonRecord) error {
+	if !e.wroteHeader {
+		if err := e.writeHeader(); err != nil {
+			return err
+		}
+	}
+	return e.writer.Write([]string{
+		strconv.Itoa(int(p.ID)), p.FullnameRU, p.FullnameEN, p.Birthday, p.Sex, p.Photo,
+		strconv.Itoa(int(p.Version)), p.CreatedAt.Format(time.RFC3339), p.CreatedBy,
+		p.UpdatedAt.Format(time.RFC3339), p.UpdatedBy,
+	})
+}
+
+func (e *csvPersonsEncoder) writeHeader() error {
+	e.wroteHeader = true
+	header := make([]string, len(exportColumns))
+	for i, column := range exportColumns {
+		header[i] = column.Name
+	}
+	return e.writer.Write(header)
+}
+
+func (e *csvPersonsEncoder) close() error {
+	// the header is written even if there are no persons
+	if !e.wroteHeader {
+		if err := e.writeHeader(); err != nil {
+			return err
+		}
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type jsonlPersonsEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e jsonlPersonsEncoder) encode(p exportPersonRecord) error {
+	return e.encoder.Encode(p)
+}
+
+func (e jsonlPersonsEncoder) close() error {
+	return nil
+}
+
+type parquetPersonsEncoder struct {
+	writer *parquet.Writer
+}
+
+func (e parquetPersonsEncoder) encode(p exportPersonRecord) error {
+	var birthday any
+	if p.Birthday != "" {
+		birthday, _ = time.Parse(time.DateOnly, p.Birthday)
+	}
+	return e.writer.Write(p.ID, p.FullnameRU, nullString(p.FullnameEN), birthday, nullString(p.Sex),
+		nullString(p.Photo), p.Version, p.CreatedAt, nullString(p.CreatedBy), p.UpdatedAt, nullString(p.UpdatedBy))
+}
+
+func (e parquetPersonsEncoder) close() error {
+	return e.writer.Close()
+}
+
+// Returns nil for the empty string
+func nullString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
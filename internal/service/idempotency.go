@@ -0,0 +1,161 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type IdempotencyConfig struct {
+	// How long responses of the requests with the idempotency keys are stored
+	KeyTTL time.Duration
+	// Request with the key, that isn't finished during this time, can be executed again
+	PendingTimeout time.Duration
+}
+
+func (c IdempotencyConfig) Validate() error {
+	if c.KeyTTL <= 0 {
+		return errors.New("key_ttl must be positive")
+	}
+	if c.PendingTimeout <= 0 {
+		return errors.New("pending_timeout must be positive")
+	}
+	return nil
+}
+
+const (
+	idempotencyKeyMetadataKey = "idempotency-key"
+	// Sent with the stored response of the repeated request
+	idempotentReplayedMetadataKey = "idempotent-replayed"
+	maxIdempotencyKeyLength       = 255
+)
+
+// Calls handle once per idempotency key from the metadata, the stored response is returned for the repeated keys.
+// If the key isn't specified, handle is called on every request.
+func withIdempotency[T proto.Message](ctx context.Context, s *MoviesPersonsService,
+	method string, in proto.Message, handle func() (T, error)) (T, error) {
+	var empty T
+	key := getIdempotencyKey(ctx)
+	if key == "" {
+		return handle()
+	}
+
+	span, spanCtx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.withIdempotency")
+	defer span.Finish()
+
+	if len(key) > maxIdempotencyKeyLength {
+		return empty, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			fmt.Sprintf("idempotency key must be shorter than %d characters", maxIdempotencyKeyLength))
+	}
+
+	requestHash, err := hashRequest(in)
+	if err != nil {
+		return empty, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	// keys are scoped by the actor, so the caller can't get the response of another caller by its key
+	idempotencyKey := repository.IdempotencyKey{
+		Key:         key,
+		Method:      method,
+		Actor:       getActor(ctx),
+		RequestHash: requestHash,
+	}
+	now := time.Now()
+	record, reserved, err := s.idempotencyRepo.ReserveIdempotencyKey(spanCtx, idempotencyKey,
+		now.Add(-s.idempotencyCfg.KeyTTL), now.Add(-s.idempotencyCfg.PendingTimeout))
+	if err != nil {
+		return empty, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	if !reserved {
+		if !bytes.Equal(record.RequestHash, requestHash) {
+			return empty, s.errorHandler.createErrorResponceWithSpan(span, ErrIdempotencyKeyReused, "")
+		} else if record.Response == nil {
+			return empty, s.errorHandler.createErrorResponceWithSpan(span, ErrIdempotentRequestInProgress, "")
+		}
+
+		res, err := unmarshalIdempotentResponse[T](record.Response)
+		if err != nil {
+			return empty, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		}
+		if err = grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedMetadataKey, "true")); err != nil {
+			s.logger.Warnf("%v while setting idempotent replayed header", err)
+		}
+		return res, nil
+	}
+
+	// key is released or response is stored even if the request is cancelled, so the request can be retried
+	storeCtx := context.WithoutCancel(spanCtx)
+	res, err := handle()
+	if err != nil {
+		if releaseErr := s.idempotencyRepo.ReleaseIdempotencyKey(storeCtx, idempotencyKey); releaseErr != nil {
+			s.logger.Errorf("%v while releasing idempotency key %s", releaseErr, key)
+		}
+		return res, err
+	}
+
+	response, err := marshalIdempotentResponse(res)
+	if err == nil {
+		err = s.idempotencyRepo.SaveIdempotentResponse(storeCtx, idempotencyKey, response)
+	}
+	if err != nil {
+		s.logger.Errorf("%v while saving response for idempotency key %s", err, key)
+	}
+	return res, nil
+}
+
+func getIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if key := md.Get(idempotencyKeyMetadataKey); len(key) > 0 {
+		return key[0]
+	}
+	return ""
+}
+
+func hashRequest(in proto.Message) ([]byte, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(raw)
+	return hash[:], nil
+}
+
+func marshalIdempotentResponse(res proto.Message) ([]byte, error) {
+	stored, err := anypb.New(res)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(stored)
+}
+
+func unmarshalIdempotentResponse[T proto.Message](response []byte) (T, error) {
+	var empty T
+	var stored anypb.Any
+	if err := proto.Unmarshal(response, &stored); err != nil {
+		return empty, err
+	}
+
+	msg, err := stored.UnmarshalNew()
+	if err != nil {
+		return empty, err
+	}
+	res, ok := msg.(T)
+	if !ok {
+		return empty, fmt.Errorf("stored response has unexpected type %s", stored.TypeUrl)
+	}
+	return res, nil
+}
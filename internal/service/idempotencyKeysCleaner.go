@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type IdempotencyKeysCleanerConfig struct {
+	// Keys older than KeyTTL are removed
+	KeyTTL time.Duration
+	// Delay between cleanups
+	Interval time.Duration
+	// Max number of keys removed per query
+	BatchSize int32
+}
+
+func (c IdempotencyKeysCleanerConfig) Validate() error {
+	if c.KeyTTL <= 0 {
+		return errors.New("key_ttl must be positive")
+	}
+	if c.Interval <= 0 {
+		return errors.New("cleanup_interval must be positive")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("cleanup_batch_size must be positive")
+	}
+	return nil
+}
+
+// idempotencyKeysCleaner removes expired idempotency keys with the stored responses.
+type idempotencyKeysCleaner struct {
+	cfg    IdempotencyKeysCleanerConfig
+	logger *logrus.Logger
+	repo   repository.IdempotencyRepository
+}
+
+func NewIdempotencyKeysCleaner(cfg IdempotencyKeysCleanerConfig, logger *logrus.Logger,
+	repo repository.IdempotencyRepository) *idempotencyKeysCleaner {
+	return &idempotencyKeysCleaner{
+		cfg:    cfg,
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// Run blocks until ctx is done.
+func (c *idempotencyKeysCleaner) Run(ctx context.Context) {
+	runBatchedCleanup(ctx, c.cfg.Interval, c.cfg.BatchSize, c.cleanBatch)
+}
+
+func (c *idempotencyKeysCleaner) cleanBatch(ctx context.Context) (int64, error) {
+	createdBefore := time.Now().Add(-c.cfg.KeyTTL)
+	deleted, err := c.repo.DeleteExpiredIdempotencyKeys(ctx, createdBefore, c.cfg.BatchSize)
+	if err != nil {
+		c.logger.Errorf("error while deleting expired idempotency keys: %v", err)
+		return 0, err
+	}
+	if deleted > 0 {
+		c.logger.Infof("deleted %d expired idempotency keys", deleted)
+	}
+	return deleted, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"runtime"
+	"strings"
 
 	image_processing_service "github.com/Falokut/image_processing_service/pkg/image_processing_service/v1/protos"
 	image_storage_service "github.com/Falokut/images_storage_service/pkg/images_storage_service/v1/protos"
@@ -38,6 +39,7 @@ type imagesService struct {
 
 type ImagesService interface {
 	GetPictureURL(ctx context.Context, pictureID string) string
+	ValidateImage(ctx context.Context, image []byte) error
 	ResizeImage(ctx context.Context, image []byte) ([]byte, error)
 	UploadImage(ctx context.Context, image []byte) (string, error)
 	DeleteImage(ctx context.Context, pictureID string) error
@@ -70,9 +72,9 @@ func (s *imagesService) GetPictureURL(ctx context.Context, pictureID string) str
 }
 
 // returns error if image not valid
-func (s *imagesService) checkImage(ctx context.Context, image []byte) error {
+func (s *imagesService) ValidateImage(ctx context.Context, image []byte) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx,
-		"ImagesService.checkImage")
+		"ImagesService.ValidateImage")
 	defer span.Finish()
 
 	img := &image_processing_service.Image{Image: image}
@@ -132,7 +134,7 @@ func (s *imagesService) UploadImage(ctx context.Context, image []byte) (string,
 		"imagesService.UploadImage")
 	defer span.Finish()
 
-	if err := s.checkImage(ctx, image); err != nil {
+	if err := s.ValidateImage(ctx, image); err != nil {
 		span.SetTag("grpc.status", status.Code(err))
 		ext.LogError(span, err)
 		return "", err
@@ -208,7 +210,7 @@ func (s *imagesService) ReplaceImage(ctx context.Context, image []byte,
 		"imagesService.ReplaceImage")
 	defer span.Finish()
 
-	if err := s.checkImage(ctx, image); err != nil {
+	if err := s.ValidateImage(ctx, image); err != nil {
 		span.SetTag("grpc.status", status.Code(err))
 		ext.LogError(span, err)
 		return "", err
@@ -239,3 +241,22 @@ func (s *imagesService) ReplaceImage(ctx context.Context, image []byte,
 	span.SetTag("grpc.status", codes.OK)
 	return resp.ImageId, nil
 }
+
+// Converts resize method name from the config into the resample filter, NearestNeighbor is used by default
+func ConvertResizeType(resizeType string) image_processing_service.ResampleFilter {
+	resizeType = strings.ToTitle(resizeType)
+	switch resizeType {
+	case "Box":
+		return image_processing_service.ResampleFilter_Box
+	case "CatmullRom":
+		return image_processing_service.ResampleFilter_CatmullRom
+	case "Lanczos":
+		return image_processing_service.ResampleFilter_Lanczos
+	case "Linear":
+		return image_processing_service.ResampleFilter_Linear
+	case "MitchellNetravali":
+		return image_processing_service.ResampleFilter_MitchellNetravali
+	default:
+		return image_processing_service.ResampleFilter_NearestNeighbor
+	}
+}
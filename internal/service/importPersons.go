@@ -0,0 +1,424 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ImportConfig struct {
+	// Directory, photo paths of the imported persons are relative to, paths aren't allowed if empty
+	PhotosDir string
+	// Max size of the imported photo in bytes
+	MaxPhotoSize int64
+	// Timeout of the photo download by the url
+	PhotoDownloadTimeout time.Duration
+	// Hosts, photos can be downloaded from, any public host is allowed if empty
+	AllowedHosts []string
+}
+
+// Max length of the jsonl line
+const maxImportLineLength = 1024 * 1024
+
+// Person in the imported file, fields are named as the csv columns
+type importPersonRow struct {
+	FullnameRU string `json:"fullname_ru"`
+	FullnameEN string `json:"fullname_en"`
+	// in format YYYY-MM-DD
+	Birthday string `json:"birthday"`
+	Sex      string `json:"sex"`
+	// http(s) url or the path relative to the photos dir
+	Photo string `json:"photo"`
+}
+
+// Returned for the rows, that can't be parsed, the import continues with the next row
+type invalidRowError struct {
+	line int32
+	msg  string
+}
+
+func (e *invalidRowError) Error() string {
+	return e.msg
+}
+
+type importRowsReader interface {
+	// Returns the row and its line in the file, returns io.EOF after the last row
+	Read() (importPersonRow, int32, error)
+}
+
+func (s *MoviesPersonsService) ImportPersons(stream movies_persons_service.MoviesPersonsServiceV1_ImportPersonsServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "MoviesPersonsService.ImportPersons")
+	defer span.Finish()
+	ctx = withActor(ctx)
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		span.SetTag("grpc.status", codes.OK)
+		return nil
+	} else if err != nil {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	rows, err := newImportRowsReader(first.Format, &importStreamReader{stream: stream, chunk: first.Chunk})
+	if errors.Is(err, ErrInvalidArgument) {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	} else if err != nil {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	opts := personsBatchOptions{dryRun: first.DryRun}
+	if first.DryRun {
+		// nothing is created in the dry run, so the duplicates in the previous batches are found only by the keys
+		opts.keys = make(map[string]int32)
+	}
+
+	batch := make([]*movies_persons_service.CreatePersonRequest, 0, streamBatchSize)
+	lines := make([]int32, 0, streamBatchSize)
+	// results of the rows, that can't be created, sent with the next batch to keep the order of the lines
+	var invalid []*movies_persons_service.BatchCreatePersonResult
+	flush := func() error {
+		results := invalid
+		if len(batch) > 0 {
+			created, err := s.createPersonsBatch(ctx, batch, lines, opts)
+			if err != nil {
+				return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+			}
+			results = append(results, created...)
+		}
+		sort.Slice(results, func(i, j int) bool { return results[i].Index < results[j].Index })
+
+		for _, res := range results {
+			if err := stream.Send(res); err != nil {
+				return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+			}
+		}
+		batch, lines, invalid = batch[:0], lines[:0], nil
+		return nil
+	}
+
+	for {
+		row, line, err := rows.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr *invalidRowError
+		if errors.As(err, &rowErr) {
+			invalid = append(invalid, &movies_persons_service.BatchCreatePersonResult{
+				Index:  rowErr.line,
+				Status: movies_persons_service.BatchCreatePersonResult_INVALID_ARGUMENT,
+				Error:  rowErr.msg,
+			})
+		} else if err != nil {
+			return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		} else if person, err := s.getImportPerson(ctx, row, first.IgnorePotentialDuplicates); err != nil {
+			invalid = append(invalid, &movies_persons_service.BatchCreatePersonResult{
+				Index:  line,
+				Status: movies_persons_service.BatchCreatePersonResult_INVALID_ARGUMENT,
+				Error:  err.Error(),
+			})
+		} else {
+			batch = append(batch, person)
+			lines = append(lines, line)
+		}
+
+		if len(batch)+len(invalid) >= streamBatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = flush(); err != nil {
+		return err
+	}
+	span.SetTag("grpc.status", codes.OK)
+	return nil
+}
+
+// Converts the row into the create request, photo is loaded from the url or the photos dir
+func (s *MoviesPersonsService) getImportPerson(ctx context.Context, row importPersonRow,
+	ignorePotentialDuplicates bool) (*movies_persons_service.CreatePersonRequest, error) {
+	in := &movies_persons_service.CreatePersonRequest{
+		FullnameRU:                row.FullnameRU,
+		IgnorePotentialDuplicates: ignorePotentialDuplicates,
+	}
+	if row.FullnameEN != "" {
+		in.FullnameEN = &row.FullnameEN
+	}
+	if row.Sex != "" {
+		in.Sex = &row.Sex
+	}
+	if row.Birthday != "" {
+		birthday, err := time.Parse(time.DateOnly, row.Birthday)
+		if err != nil {
+			return nil, fmt.Errorf("invalid birthday %s, birthday must be in format YYYY-MM-DD", row.Birthday)
+		}
+		in.Birthday = timestamppb.New(birthday)
+	}
+	if row.Photo != "" {
+		photo, err := s.loadImportPhoto(ctx, row.Photo)
+		if err != nil {
+			return nil, fmt.Errorf("can't load photo %s: %w", row.Photo, err)
+		}
+		in.Photo = photo
+	}
+	return in, nil
+}
+
+func (s *MoviesPersonsService) loadImportPhoto(ctx context.Context, photo string) ([]byte, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.loadImportPhoto")
+	defer span.Finish()
+	var err error
+	defer span.SetTag("error", err != nil)
+
+	var body io.ReadCloser
+	if u, parseErr := url.Parse(photo); parseErr == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		body, err = s.downloadImportPhoto(ctx, u.String())
+	} else {
+		body, err = s.openImportPhoto(photo)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, s.importCfg.MaxPhotoSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.importCfg.MaxPhotoSize {
+		err = fmt.Errorf("photo size exceeds %d bytes", s.importCfg.MaxPhotoSize)
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *MoviesPersonsService) downloadImportPhoto(ctx context.Context, photoURL string) (io.ReadCloser, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.importCfg.PhotoDownloadTimeout)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, photoURL, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err = checkImportPhotoHost(s.importCfg.AllowedHosts, req.URL); err != nil {
+		cancel()
+		return nil, err
+	}
+	res, err := s.importPhotoClient.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		cancel()
+		return nil, fmt.Errorf("unexpected response status %s", res.Status)
+	}
+	return &cancelReadCloser{ReadCloser: res.Body, cancel: cancel}, nil
+}
+
+// Opens the photo from the photos dir, the path mustn't point outside of the dir
+func (s *MoviesPersonsService) openImportPhoto(path string) (io.ReadCloser, error) {
+	if s.importCfg.PhotosDir == "" {
+		return nil, errors.New("photo paths aren't allowed, photos directory isn't configured")
+	}
+	if !filepath.IsLocal(path) {
+		return nil, errors.New("photo path must be relative to the photos directory")
+	}
+	f, err := os.Open(filepath.Join(s.importCfg.PhotosDir, path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.New("photo not found")
+	}
+	return f, err
+}
+
+// Cancels the request context after the body is closed
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// Reads chunks of the file from the stream
+type importStreamReader struct {
+	stream movies_persons_service.MoviesPersonsServiceV1_ImportPersonsServer
+	chunk  []byte
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		in, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = in.Chunk
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func newImportRowsReader(format movies_persons_service.ImportPersonsRequest_Format,
+	r io.Reader) (importRowsReader, error) {
+	switch format {
+	case movies_persons_service.ImportPersonsRequest_CSV:
+		return newCSVImportReader(r)
+	case movies_persons_service.ImportPersonsRequest_JSONL:
+		j := &jsonlImportReader{scanner: bufio.NewScanner(r)}
+		j.scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxImportLineLength)
+		j.scanner.Split(j.splitLines)
+		return j, nil
+	default:
+		return nil, fmt.Errorf("%s error: %w", "unsupported format", ErrInvalidArgument)
+	}
+}
+
+type csvImportReader struct {
+	r *csv.Reader
+	// indexes of the columns by their names
+	columns map[string]int
+}
+
+func newCSVImportReader(r io.Reader) (*csvImportReader, error) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s error: %w", "csv header is missing", ErrInvalidArgument)
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return nil, fmt.Errorf("%s error: %w", "invalid csv header: "+parseErr.Error(), ErrInvalidArgument)
+	} else if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		// excel adds the byte order mark to the utf-8 files
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	if _, ok := columns["fullname_ru"]; !ok {
+		return nil, fmt.Errorf("%s error: %w", "csv header must contain fullname_ru column", ErrInvalidArgument)
+	}
+	return &csvImportReader{r: reader, columns: columns}, nil
+}
+
+func (c *csvImportReader) Read() (importPersonRow, int32, error) {
+	record, err := c.r.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return importPersonRow{}, int32(parseErr.StartLine), &invalidRowError{
+			line: int32(parseErr.StartLine),
+			msg:  "invalid csv row: " + parseErr.Err.Error(),
+		}
+	} else if err != nil {
+		return importPersonRow{}, 0, err
+	}
+
+	line, _ := c.r.FieldPos(0)
+	return importPersonRow{
+		FullnameRU: c.get(record, "fullname_ru"),
+		FullnameEN: c.get(record, "fullname_en"),
+		Birthday:   c.get(record, "birthday"),
+		Sex:        c.get(record, "sex"),
+		Photo:      c.get(record, "photo"),
+	}, int32(line), nil
+}
+
+func (c *csvImportReader) get(record []string, column string) string {
+	i, ok := c.columns[column]
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+type jsonlImportReader struct {
+	scanner *bufio.Scanner
+	line    int32
+	// set while the rest of the line, longer than the max length, is skipped
+	skipping bool
+	// set if the scanned line was longer than the max length
+	tooLong bool
+}
+
+// Splits the data like bufio.ScanLines, but the lines longer than the max length are skipped
+// instead of failing the scanner with bufio.ErrTooLong, so the import continues with the next line
+func (j *jsonlImportReader) splitLines(data []byte, atEOF bool) (int, []byte, error) {
+	if j.skipping {
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			j.skipping, j.tooLong = false, true
+			return i + 1, data[:0], nil
+		}
+		if atEOF {
+			j.skipping, j.tooLong = false, true
+			return len(data), data[:0], nil
+		}
+		return len(data), nil, nil
+	}
+
+	advance, token, err := bufio.ScanLines(data, atEOF)
+	if advance == 0 && token == nil && err == nil && len(data) >= maxImportLineLength {
+		j.skipping = true
+		return len(data), nil, nil
+	}
+	j.tooLong = false
+	return advance, token, err
+}
+
+func (j *jsonlImportReader) Read() (importPersonRow, int32, error) {
+	for j.scanner.Scan() {
+		j.line++
+		if j.tooLong {
+			return importPersonRow{}, j.line, &invalidRowError{line: j.line,
+				msg: fmt.Sprintf("line is longer than %d bytes", maxImportLineLength)}
+		}
+		data := bytes.TrimSpace(j.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var row importPersonRow
+		if err := json.Unmarshal(data, &row); err != nil {
+			return importPersonRow{}, j.line, &invalidRowError{line: j.line, msg: "invalid json: " + err.Error()}
+		}
+		row.FullnameRU = strings.TrimSpace(row.FullnameRU)
+		row.FullnameEN = strings.TrimSpace(row.FullnameEN)
+		row.Birthday = strings.TrimSpace(row.Birthday)
+		row.Sex = strings.TrimSpace(row.Sex)
+		row.Photo = strings.TrimSpace(row.Photo)
+		return row, j.line, nil
+	}
+
+	if err := j.scanner.Err(); err != nil {
+		return importPersonRow{}, 0, err
+	}
+	return importPersonRow{}, 0, io.EOF
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+)
+
+func TestJSONLImportReaderLongLines(t *testing.T) {
+	long := `{"fullname_ru":"` + strings.Repeat("а", maxImportLineLength) + `"}`
+	tests := []struct {
+		name string
+		data string
+		// names of the read rows, empty for the invalid rows
+		want      []string
+		wantLines []int32
+	}{
+		{"long line in the middle", `{"fullname_ru":"Иван"}` + "\n" + long + "\n" + `{"fullname_ru":"Петр"}`,
+			[]string{"Иван", "", "Петр"}, []int32{1, 2, 3}},
+		{"long first line", long + "\n\n" + `{"fullname_ru":"Петр"}` + "\n",
+			[]string{"", "Петр"}, []int32{1, 3}},
+		{"long last line without newline", `{"fullname_ru":"Иван"}` + "\n" + long,
+			[]string{"Иван", ""}, []int32{1, 2}},
+	}
+
+	for _, tt := range tests {
+		rows, err := newImportRowsReader(movies_persons_service.ImportPersonsRequest_JSONL, strings.NewReader(tt.data))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		var got []string
+		var gotLines []int32
+		for {
+			row, line, err := rows.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			var rowErr *invalidRowError
+			if errors.As(err, &rowErr) {
+				line = rowErr.line
+			} else if err != nil {
+				t.Fatalf("%s: read: %v", tt.name, err)
+			}
+			got, gotLines = append(got, row.FullnameRU), append(gotLines, line)
+		}
+
+		if !slices.Equal(got, tt.want) || !slices.Equal(gotLines, tt.wantLines) {
+			t.Errorf("%s: got rows %q lines %v, want %q lines %v", tt.name, got, gotLines, tt.want, tt.wantLines)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Max number of redirects followed during the imported photo download
+const maxImportPhotoRedirects = 3
+
+var errImportPhotoAddressNotAllowed = errors.New("photo address isn't allowed")
+
+// Returns the client for the imported photos downloads, it doesn't connect to the loopback,
+// private and link-local addresses, so the urls from the imported files can't reach the internal services
+func newImportPhotoClient(cfg ImportConfig) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkImportPhotoAddress,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// the proxy address would be checked instead of the photo one
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxImportPhotoRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxImportPhotoRedirects)
+			}
+			return checkImportPhotoHost(cfg.AllowedHosts, req.URL)
+		},
+	}
+}
+
+// Called with the resolved address before the connection, so the check can't be bypassed by the dns
+func checkImportPhotoAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return errImportPhotoAddressNotAllowed
+	}
+	return nil
+}
+
+// Checks, that the url host is in the allowed hosts, any host is allowed, if allowed hosts are empty
+func checkImportPhotoHost(allowedHosts []string, u *url.URL) error {
+	if len(allowedHosts) == 0 {
+		return nil
+	}
+	host := u.Hostname()
+	for _, allowed := range allowedHosts {
+		if strings.EqualFold(host, allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("photo host %s isn't allowed", host)
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCheckImportPhotoAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{"93.184.216.34:443", true},
+		{"[2606:2800:220:1:248:1893:25c8:1946]:443", true},
+		{"127.0.0.1:80", false},
+		{"[::1]:80", false},
+		{"10.0.0.1:80", false},
+		{"172.16.5.4:80", false},
+		{"192.168.1.1:80", false},
+		{"169.254.169.254:80", false},
+		{"[fe80::1]:80", false},
+		{"[fd00::1]:80", false},
+		{"0.0.0.0:80", false},
+		{"[::ffff:127.0.0.1]:80", false},
+		{"224.0.0.1:80", false},
+	}
+
+	for _, tt := range tests {
+		err := checkImportPhotoAddress("tcp", tt.address, nil)
+		if allowed := err == nil; allowed != tt.allowed {
+			t.Errorf("checkImportPhotoAddress(%q) = %v, want allowed %t", tt.address, err, tt.allowed)
+		}
+	}
+}
+
+func TestCheckImportPhotoHost(t *testing.T) {
+	tests := []struct {
+		allowedHosts []string
+		url          string
+		allowed      bool
+	}{
+		{nil, "http://example.com/photo.jpg", true},
+		{[]string{"images.example.com"}, "https://images.example.com/photo.jpg", true},
+		{[]string{"images.example.com"}, "https://IMAGES.example.com:8443/photo.jpg", true},
+		{[]string{"images.example.com"}, "https://example.com/photo.jpg", false},
+		{[]string{"images.example.com"}, "https://images.example.com.evil.com/photo.jpg", false},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatalf("parse %s: %v", tt.url, err)
+		}
+		err = checkImportPhotoHost(tt.allowedHosts, u)
+		if allowed := err == nil; allowed != tt.allowed {
+			t.Errorf("checkImportPhotoHost(%q, %s) = %v, want allowed %t", tt.allowedHosts, tt.url, err, tt.allowed)
+		}
+	}
+}
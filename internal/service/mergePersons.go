@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *MoviesPersonsService) MergePersons(ctx context.Context,
+	in *movies_persons_service.MergePersonsRequest) (*movies_persons_service.MergePersonsResponse, error) {
+	return withIdempotency(ctx, s, "MergePersons", in, func() (*movies_persons_service.MergePersonsResponse, error) {
+		return s.mergePersons(ctx, in)
+	})
+}
+
+func (s *MoviesPersonsService) mergePersons(ctx context.Context,
+	in *movies_persons_service.MergePersonsRequest) (*movies_persons_service.MergePersonsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.MergePersons")
+	defer span.Finish()
+	ctx = withActor(ctx)
+
+	if in.TargetID <= 0 {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			"target_id must be > 0")
+	}
+
+	ids := map[int32]struct{}{in.TargetID: {}}
+	sourceIDs := make([]int32, 0, len(in.SourceIDs))
+	for _, id := range in.SourceIDs {
+		if id == in.TargetID {
+			return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+				"source_ids mustn't contain target_id")
+		}
+		if _, ok := ids[id]; !ok {
+			ids[id] = struct{}{}
+			sourceIDs = append(sourceIDs, id)
+		}
+	}
+	if len(sourceIDs) == 0 {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			"source_ids mustn't be empty")
+	}
+
+	resolution := repository.MergeFieldResolution{
+		FullnameRU: in.GetFieldResolution().GetFullnameRU(),
+		FullnameEN: in.GetFieldResolution().GetFullnameEN(),
+		Birthday:   in.GetFieldResolution().GetBirthday(),
+		Sex:        in.GetFieldResolution().GetSex(),
+		PhotoID:    in.GetFieldResolution().GetPhoto(),
+	}
+	for _, id := range []int32{resolution.FullnameRU, resolution.FullnameEN,
+		resolution.Birthday, resolution.Sex, resolution.PhotoID} {
+		if _, ok := ids[id]; id != 0 && !ok {
+			return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+				"field_resolution must contain only target_id, source_ids or 0")
+		}
+	}
+
+	err := s.repo.MergePersons(ctx, sourceIDs, in.TargetID, resolution)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
+	} else if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return &movies_persons_service.MergePersonsResponse{TargetID: in.TargetID, MergedIDs: sourceIDs}, nil
+}
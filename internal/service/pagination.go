@@ -0,0 +1,203 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+)
+
+var ErrInvalidPageToken = fmt.Errorf("%s error: %w", "invalid page_token", ErrInvalidArgument)
+var ErrInvalidOrderBy = fmt.Errorf("%s error: %w", "invalid order_by", ErrInvalidArgument)
+
+// Opaque for the clients, encoded as base64 url encoded json
+type pageToken struct {
+	// id of the last person on the previous page
+	AfterID int32 `json:"after_id,omitempty"`
+	// order_by value of the last person on the previous page, nil if the value is null
+	AfterValue *string `json:"after_value,omitempty"`
+	// used instead of AfterID for the orders, that can't be paginated by keyset
+	Offset  int32  `json:"offset,omitempty"`
+	OrderBy string `json:"order_by,omitempty"`
+}
+
+// Implemented by the list requests
+type listRequest interface {
+	GetLimit() int32
+	GetPage() int32
+	GetPageToken() string
+	GetOrderBy() string
+}
+
+func encodePageToken(token pageToken) string {
+	body, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(body)
+}
+
+func decodePageToken(encoded string) (pageToken, error) {
+	body, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return pageToken{}, ErrInvalidPageToken
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(body, &token); err != nil || (token.AfterID <= 0 && token.Offset <= 0) {
+		return pageToken{}, ErrInvalidPageToken
+	}
+	return token, nil
+}
+
+var allowedOrderFields = map[string]repository.PersonsOrder{
+	"id":          repository.OrderByID,
+	"fullname_ru": repository.OrderByFullnameRU,
+	"fullname_en": repository.OrderByFullnameEN,
+	"birthday":    repository.OrderByBirthday,
+	"created_at":  repository.OrderByCreatedAt,
+	"updated_at":  repository.OrderByUpdatedAt,
+}
+
+// Parses order_by in format "field [asc|desc]", if order_by is empty, defaultOrder is returned
+func parseOrderBy(orderBy string, defaultOrder repository.PersonsOrder,
+	relevanceAllowed bool) (repository.PersonsOrder, bool, error) {
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) == 0 {
+		return defaultOrder, false, nil
+	}
+
+	if parts[0] == string(repository.OrderByRelevance) {
+		if !relevanceAllowed || len(parts) > 1 {
+			return "", false, ErrInvalidOrderBy
+		}
+		return repository.OrderByRelevance, false, nil
+	}
+
+	field, ok := allowedOrderFields[parts[0]]
+	if !ok || len(parts) > 2 {
+		return "", false, ErrInvalidOrderBy
+	}
+	if len(parts) == 1 || parts[1] == "asc" {
+		return field, false, nil
+	} else if parts[1] == "desc" {
+		return field, true, nil
+	}
+	return "", false, ErrInvalidOrderBy
+}
+
+// Returns keyset page if page token isn't empty, otherwise offset page
+func getPage(in listRequest, defaultOrder repository.PersonsOrder, relevanceAllowed bool) (repository.Page, error) {
+	orderBy, desc, err := parseOrderBy(in.GetOrderBy(), defaultOrder, relevanceAllowed)
+	if err != nil {
+		return repository.Page{}, err
+	}
+
+	page := repository.Page{Limit: in.GetLimit(), OrderBy: orderBy, Desc: desc}
+	if in.GetPageToken() == "" {
+		if err := validateLimitAndPage(in.GetPage(), in.GetLimit()); err != nil {
+			return repository.Page{}, err
+		}
+		page.Offset = in.GetLimit() * (in.GetPage() - 1)
+		return page, nil
+	}
+
+	if err := validateLimit(in.GetLimit()); err != nil {
+		return repository.Page{}, err
+	}
+	token, err := decodePageToken(in.GetPageToken())
+	if err != nil {
+		return repository.Page{}, err
+	}
+	// token can be used only with the same order
+	if token.OrderBy != in.GetOrderBy() {
+		return repository.Page{}, ErrInvalidPageToken
+	}
+
+	if orderBy == repository.OrderByRelevance {
+		page.Offset = token.Offset
+		return page, nil
+	}
+	if token.AfterID <= 0 {
+		return repository.Page{}, ErrInvalidPageToken
+	}
+	page.AfterID = token.AfterID
+	if token.AfterValue == nil {
+		return page, nil
+	}
+
+	if orderBy == repository.OrderByBirthday {
+		birthday, err := time.Parse(time.DateOnly, *token.AfterValue)
+		if err != nil {
+			return repository.Page{}, ErrInvalidPageToken
+		}
+		page.AfterValue = birthday
+	} else if orderBy == repository.OrderByCreatedAt || orderBy == repository.OrderByUpdatedAt {
+		changedAt, err := time.Parse(time.RFC3339Nano, *token.AfterValue)
+		if err != nil {
+			return repository.Page{}, ErrInvalidPageToken
+		}
+		page.AfterValue = changedAt
+	} else {
+		page.AfterValue = *token.AfterValue
+	}
+	return page, nil
+}
+
+// Fuzzy search supports only relevance order
+func getSearchPersonByNamePage(in *movies_persons_service.SearchPersonByNameRequest) (repository.Page, error) {
+	if in.Mode != movies_persons_service.SearchPersonByNameRequest_FUZZY {
+		return getPage(in, repository.OrderByID, true)
+	}
+
+	page, err := getPage(in, repository.OrderByRelevance, true)
+	if err != nil {
+		return repository.Page{}, err
+	}
+	if page.OrderBy != repository.OrderByRelevance {
+		return repository.Page{}, ErrInvalidOrderBy
+	}
+	return page, nil
+}
+
+// Returns token for the next page or empty string, if persons is the last page,
+// page must be the same page, that was used to get persons.
+func getNextPageToken(persons []repository.Person, page repository.Page, orderBy string) string {
+	if len(persons) == 0 || len(persons) < int(page.Limit) {
+		return ""
+	}
+
+	if page.OrderBy == repository.OrderByRelevance {
+		return encodePageToken(pageToken{Offset: page.Offset + page.Limit, OrderBy: orderBy})
+	}
+
+	last := persons[len(persons)-1]
+	lastID, err := strconv.Atoi(last.ID)
+	if err != nil {
+		return ""
+	}
+
+	token := pageToken{AfterID: int32(lastID), OrderBy: orderBy}
+	switch page.OrderBy {
+	case repository.OrderByFullnameRU:
+		token.AfterValue = &last.FullnameRU
+	case repository.OrderByFullnameEN:
+		if last.FullnameEN.Valid {
+			token.AfterValue = &last.FullnameEN.String
+		}
+	case repository.OrderByBirthday:
+		if last.Birthday.Valid {
+			birthday := last.Birthday.Time.Format(time.DateOnly)
+			token.AfterValue = &birthday
+		}
+	case repository.OrderByCreatedAt:
+		createdAt := last.CreatedAt.Format(time.RFC3339Nano)
+		token.AfterValue = &createdAt
+	case repository.OrderByUpdatedAt:
+		updatedAt := last.UpdatedAt.Format(time.RFC3339Nano)
+		token.AfterValue = &updatedAt
+	}
+	return encodePageToken(token)
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	value := "Тарковский"
+	tests := []pageToken{
+		{AfterID: 1},
+		{AfterID: 42, AfterValue: &value, OrderBy: "fullname_ru desc"},
+		{AfterID: 7, OrderBy: "birthday"},
+		{Offset: 20, OrderBy: "relevance"},
+	}
+
+	for _, want := range tests {
+		got, err := decodePageToken(encodePageToken(want))
+		if err != nil {
+			t.Errorf("decodePageToken(encodePageToken(%+v)): %v", want, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("decodePageToken(encodePageToken(%+v)) = %+v", want, got)
+		}
+	}
+}
+
+func TestDecodePageTokenRejectsTampered(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "not a token!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"after_id":1}`))},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("after_id=1"))},
+		{"empty json", base64.RawURLEncoding.EncodeToString([]byte("{}"))},
+		{"negative id", base64.RawURLEncoding.EncodeToString([]byte(`{"after_id":-1}`))},
+		{"negative offset", base64.RawURLEncoding.EncodeToString([]byte(`{"offset":-10}`))},
+		{"string id", base64.RawURLEncoding.EncodeToString([]byte(`{"after_id":"1"}`))},
+	}
+
+	for _, tt := range tests {
+		if _, err := decodePageToken(tt.token); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, ErrInvalidPageToken)
+		}
+	}
+}
+
+func TestGetPageWithToken(t *testing.T) {
+	birthday := "1932-04-04"
+	invalidBirthday := "04.04.1932"
+	tests := []struct {
+		name    string
+		token   pageToken
+		orderBy string
+		want    repository.Page
+		wantErr error
+	}{
+		{"id order", pageToken{AfterID: 10}, "",
+			repository.Page{Limit: 10, OrderBy: repository.OrderByID, AfterID: 10}, nil},
+		{"birthday order", pageToken{AfterID: 10, AfterValue: &birthday, OrderBy: "birthday desc"}, "birthday desc",
+			repository.Page{Limit: 10, OrderBy: repository.OrderByBirthday, Desc: true, AfterID: 10,
+				AfterValue: time.Date(1932, 4, 4, 0, 0, 0, 0, time.UTC)}, nil},
+		{"null value", pageToken{AfterID: 10, OrderBy: "birthday"}, "birthday",
+			repository.Page{Limit: 10, OrderBy: repository.OrderByBirthday, AfterID: 10}, nil},
+		{"another order", pageToken{AfterID: 10, OrderBy: "birthday"}, "birthday desc",
+			repository.Page{}, ErrInvalidPageToken},
+		{"invalid value", pageToken{AfterID: 10, AfterValue: &invalidBirthday, OrderBy: "birthday"}, "birthday",
+			repository.Page{}, ErrInvalidPageToken},
+		{"offset without relevance order", pageToken{Offset: 10}, "",
+			repository.Page{}, ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		got, err := getPage(&movies_persons_service.GetPersonsRequest{
+			Limit:     10,
+			PageToken: encodePageToken(tt.token),
+			OrderBy:   tt.orderBy,
+		}, repository.OrderByID, false)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got page %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy          string
+		relevanceAllowed bool
+		want             repository.PersonsOrder
+		wantDesc         bool
+		wantErr          error
+	}{
+		{"", false, repository.OrderByID, false, nil},
+		{"   ", false, repository.OrderByID, false, nil},
+		{"fullname_ru", false, repository.OrderByFullnameRU, false, nil},
+		{"fullname_en asc", false, repository.OrderByFullnameEN, false, nil},
+		{"Birthday DESC", false, repository.OrderByBirthday, true, nil},
+		{"  updated_at   desc ", false, repository.OrderByUpdatedAt, true, nil},
+		{"created_at", false, repository.OrderByCreatedAt, false, nil},
+		{"relevance", true, repository.OrderByRelevance, false, nil},
+		{"relevance", false, "", false, ErrInvalidOrderBy},
+		{"relevance desc", true, "", false, ErrInvalidOrderBy},
+		{"photo_id", false, "", false, ErrInvalidOrderBy},
+		{"sex", false, "", false, ErrInvalidOrderBy},
+		{"id; DROP TABLE persons", false, "", false, ErrInvalidOrderBy},
+		{"id sideways", false, "", false, ErrInvalidOrderBy},
+		{"id asc desc", false, "", false, ErrInvalidOrderBy},
+		{"fullname_ru,id", false, "", false, ErrInvalidOrderBy},
+	}
+
+	for _, tt := range tests {
+		got, desc, err := parseOrderBy(tt.orderBy, repository.OrderByID, tt.relevanceAllowed)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("parseOrderBy(%q): got error %v, want %v", tt.orderBy, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want || desc != tt.wantDesc {
+			t.Errorf("parseOrderBy(%q) = %s, desc %t, want %s, desc %t", tt.orderBy, got, desc, tt.want, tt.wantDesc)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *MoviesPersonsService) ListPersonRevisions(ctx context.Context,
+	in *movies_persons_service.ListPersonRevisionsRequest) (*movies_persons_service.PersonRevisions, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.ListPersonRevisions")
+	defer span.Finish()
+
+	offset := in.Limit * (in.Page - 1)
+	if err := validateLimitAndPage(in.Page, in.Limit); err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+
+	revisions, err := s.repo.GetPersonRevisions(ctx, in.PersonID, in.Limit, offset)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
+	} else if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	converted := &movies_persons_service.PersonRevisions{
+		Revisions: make([]*movies_persons_service.PersonRevision, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		converted.Revisions = append(converted.Revisions, s.convertPersonRevision(ctx, revision))
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return converted, nil
+}
+
+func (s *MoviesPersonsService) GetPersonRevisionDiff(ctx context.Context,
+	in *movies_persons_service.GetPersonRevisionDiffRequest) (*movies_persons_service.PersonRevisionDiff, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.GetPersonRevisionDiff")
+	defer span.Finish()
+
+	if in.FromRevision <= 0 || in.ToRevision <= 0 {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			"from_revision and to_revision must be > 0")
+	}
+
+	from, err := s.repo.GetPersonRevision(ctx, in.PersonID, in.FromRevision)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "from_revision not found")
+	} else if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	to, err := s.repo.GetPersonRevision(ctx, in.PersonID, in.ToRevision)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "to_revision not found")
+	} else if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	convertedFrom := s.convertPersonRevision(ctx, from)
+	convertedTo := s.convertPersonRevision(ctx, to)
+
+	span.SetTag("grpc.status", codes.OK)
+	return &movies_persons_service.PersonRevisionDiff{
+		From:    convertedFrom,
+		To:      convertedTo,
+		Changes: getRevisionsDiff(convertedFrom, convertedTo),
+	}, nil
+}
+
+func (s *MoviesPersonsService) RollbackPersonToRevision(ctx context.Context,
+	in *movies_persons_service.RollbackPersonToRevisionRequest) (*emptypb.Empty, error) {
+	return withIdempotency(ctx, s, "RollbackPersonToRevision", in, func() (*emptypb.Empty, error) {
+		return s.rollbackPersonToRevision(ctx, in)
+	})
+}
+
+func (s *MoviesPersonsService) rollbackPersonToRevision(ctx context.Context,
+	in *movies_persons_service.RollbackPersonToRevisionRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.RollbackPersonToRevision")
+	defer span.Finish()
+	ctx = withActor(ctx)
+
+	if in.Revision <= 0 {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			"revision must be > 0")
+	}
+
+	err := s.repo.RollbackPerson(ctx, in.PersonID, in.Revision)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
+	} else if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MoviesPersonsService) convertPersonRevision(ctx context.Context,
+	revision repository.PersonRevision) *movies_persons_service.PersonRevision {
+	birthday := ""
+	if revision.Birthday.Valid {
+		birthday = revision.Birthday.Time.Format("2006-01-02")
+	}
+
+	return &movies_persons_service.PersonRevision{
+		Revision:   revision.Revision,
+		Action:     revision.Action,
+		FullnameRU: revision.FullnameRU,
+		FullnameEN: revision.FullnameEN.String,
+		Birthday:   birthday,
+		Sex:        revision.Sex.String,
+		PhotoUrl:   s.imagesService.GetPictureURL(ctx, revision.PhotoID.String),
+		ChangedBy:  revision.ChangedBy.String,
+		ChangedAt:  timestamppb.New(revision.ChangedAt),
+	}
+}
+
+func getRevisionsDiff(from, to *movies_persons_service.PersonRevision) []*movies_persons_service.PersonFieldDiff {
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"fullname_ru", from.FullnameRU, to.FullnameRU},
+		{"fullname_en", from.FullnameEN, to.FullnameEN},
+		{"birthday", from.Birthday, to.Birthday},
+		{"sex", from.Sex, to.Sex},
+		{"photo_url", from.PhotoUrl, to.PhotoUrl},
+	}
+
+	var diff = make([]*movies_persons_service.PersonFieldDiff, 0, len(fields))
+	for _, field := range fields {
+		if field.from != field.to {
+			diff = append(diff, &movies_persons_service.PersonFieldDiff{
+				Field:    field.name,
+				OldValue: field.from,
+				NewValue: field.to,
+			})
+		}
+	}
+	return diff
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type PersonsChangesCleanerConfig struct {
+	// Changes older than RetentionPeriod are removed, watchers can't resume from the removed revisions
+	RetentionPeriod time.Duration
+	// Delay between cleanups
+	Interval time.Duration
+	// Max number of changes removed per query
+	BatchSize int32
+}
+
+func (c PersonsChangesCleanerConfig) Validate() error {
+	if c.RetentionPeriod <= 0 {
+		return errors.New("changes_retention_period must be positive")
+	}
+	if c.Interval <= 0 {
+		return errors.New("cleanup_interval must be positive")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("cleanup_batch_size must be positive")
+	}
+	return nil
+}
+
+// personsChangesCleaner removes old changes from the persons changes log.
+type personsChangesCleaner struct {
+	cfg    PersonsChangesCleanerConfig
+	logger *logrus.Logger
+	repo   repository.PersonsChangesRepository
+}
+
+func NewPersonsChangesCleaner(cfg PersonsChangesCleanerConfig, logger *logrus.Logger,
+	repo repository.PersonsChangesRepository) *personsChangesCleaner {
+	return &personsChangesCleaner{
+		cfg:    cfg,
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// Run blocks until ctx is done.
+func (c *personsChangesCleaner) Run(ctx context.Context) {
+	runBatchedCleanup(ctx, c.cfg.Interval, c.cfg.BatchSize, c.cleanBatch)
+}
+
+func (c *personsChangesCleaner) cleanBatch(ctx context.Context) (int64, error) {
+	changedBefore := time.Now().Add(-c.cfg.RetentionPeriod)
+	deleted, err := c.repo.DeleteOldPersonsChanges(ctx, changedBefore, c.cfg.BatchSize)
+	if err != nil {
+		c.logger.Errorf("error while deleting old persons changes: %v", err)
+		return 0, err
+	}
+	if deleted > 0 {
+		c.logger.Infof("deleted %d old persons changes", deleted)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *MoviesPersonsService) GetPersonsV2(ctx context.Context,
+	in *movies_persons_service.GetPersonsRequest) (*movies_persons_service.PersonsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.GetPersonsV2")
+	defer span.Finish()
+
+	page, err := getPage(in, repository.OrderByID, false)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+	filter, err := getPersonsFilter(in)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+
+	// one more person is requested to find out if there is a next page
+	fetchPage := page
+	fetchPage.Limit++
+	var persons []repository.Person
+	var redirects map[int32]int32
+	var total int64
+	if in.PersonsIDs == "" {
+		persons, err = s.repo.GetAllPersons(ctx, filter, fetchPage)
+		if err == nil {
+			total, err = s.repo.GetAllPersonsCount(ctx, filter)
+		}
+	} else {
+		in.PersonsIDs = strings.TrimSpace(strings.ReplaceAll(in.PersonsIDs, `"`, ""))
+		if err := checkParam(in.PersonsIDs); err != nil {
+			return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+		}
+
+		ids := convertStringsSlice(strings.Split(in.PersonsIDs, ","))
+		persons, err = s.repo.GetPersons(ctx, ids, filter, fetchPage)
+		if err == nil || errors.Is(err, repository.ErrNotFound) {
+			total, err = s.repo.GetPersonsCount(ctx, ids, filter)
+		}
+		if err == nil {
+			redirects, err = s.repo.GetPersonsRedirects(ctx, ids)
+		}
+	}
+
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	converted := s.convertPersonsList(ctx, persons, total, page, in.OrderBy)
+	converted.RedirectedIDs = redirects
+
+	span.SetTag("grpc.status", codes.OK)
+	return converted, nil
+}
+
+func (s *MoviesPersonsService) SearchPersonV2(ctx context.Context,
+	in *movies_persons_service.SearchPersonRequest) (*movies_persons_service.PersonsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.SearchPersonV2")
+	defer span.Finish()
+
+	page, err := getPage(in, repository.OrderByID, false)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+
+	param := repository.SearchPersonParam{
+		FullnameRU: in.GetFullnameRU(),
+		FullnameEN: in.GetFullnameEN(),
+		Birthday:   getTimeFromTimestamp(in.Birthday),
+		Sex:        in.GetSex(),
+	}
+
+	// one more person is requested to find out if there is a next page
+	fetchPage := page
+	fetchPage.Limit++
+	var total int64
+	persons, err := s.repo.SearchPerson(ctx, param, fetchPage)
+	if err == nil {
+		total, err = s.repo.SearchPersonCount(ctx, param)
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrInvalidArgument):
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, "")
+	case err == nil, errors.Is(err, repository.ErrNotFound):
+		span.SetTag("grpc.status", codes.OK)
+		return s.convertPersonsList(ctx, persons, total, page, in.OrderBy), nil
+	default:
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+}
+
+func (s *MoviesPersonsService) SearchPersonByNameV2(ctx context.Context,
+	in *movies_persons_service.SearchPersonByNameRequest) (*movies_persons_service.PersonsList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.SearchPersonByNameV2")
+	defer span.Finish()
+
+	if in.Name == "" {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "", "name mustn't be empty")
+	}
+
+	page, err := getSearchPersonByNamePage(in)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+
+	// one more person is requested to find out if there is a next page
+	fetchPage := page
+	fetchPage.Limit++
+	var persons []repository.Person
+	var scores []float32
+	var total int64
+	if in.Mode == movies_persons_service.SearchPersonByNameRequest_FUZZY {
+		var scored []repository.ScoredPerson
+		scored, err = s.repo.FuzzySearchPersonByName(ctx, in.Name, fetchPage)
+		persons, scores = splitScoredPersons(scored)
+		if err == nil {
+			total, err = s.repo.FuzzySearchPersonByNameCount(ctx, in.Name)
+		}
+	} else {
+		persons, err = s.repo.SearchPersonByName(ctx, in.Name, fetchPage)
+		if err == nil {
+			total, err = s.repo.SearchPersonByNameCount(ctx, in.Name)
+		}
+	}
+
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	converted := s.convertPersonsList(ctx, persons, total, page, in.OrderBy)
+	for i, person := range converted.Persons {
+		if i < len(scores) {
+			person.Score = scores[i]
+		}
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return converted, nil
+}
+
+// persons must be requested with page.Limit+1 to find out if there is a next page
+func (s *MoviesPersonsService) convertPersonsList(ctx context.Context, persons []repository.Person,
+	total int64, page repository.Page, orderBy string) *movies_persons_service.PersonsList {
+	hasMore := len(persons) > int(page.Limit)
+	if hasMore {
+		persons = persons[:page.Limit]
+	}
+
+	converted := &movies_persons_service.PersonsList{
+		Persons:    make([]*movies_persons_service.Person, 0, len(persons)),
+		TotalCount: total,
+		HasMore:    hasMore,
+	}
+	for _, p := range persons {
+		converted.Persons = append(converted.Persons, s.convertPerson(ctx, p))
+	}
+	if hasMore {
+		converted.NextPageToken = getNextPageToken(persons, page, orderBy)
+	}
+
+	return converted
+}
+
+func (s *MoviesPersonsService) convertPerson(ctx context.Context, p repository.Person) *movies_persons_service.Person {
+	birthday := ""
+	if p.Birthday.Valid {
+		birthday = p.Birthday.Time.Format("2006-01-02")
+	}
+	id, _ := strconv.Atoi(p.ID)
+
+	converted := &movies_persons_service.Person{
+		ID:         int32(id),
+		FullnameRU: p.FullnameRU,
+		FullnameEN: p.FullnameEN.String,
+		Birthday:   birthday,
+		Sex:        p.Sex.String,
+		PhotoUrl:   s.imagesService.GetPictureURL(ctx, p.PhotoID.String),
+		Version:    p.Version,
+		CreatedBy:  p.CreatedBy.String,
+		UpdatedBy:  p.UpdatedBy.String,
+	}
+	if !p.CreatedAt.IsZero() {
+		converted.CreatedAt = timestamppb.New(p.CreatedAt)
+	}
+	if !p.UpdatedAt.IsZero() {
+		converted.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+	return converted
+}
+
+// Returns filter by the persons creation and last change time, ranges must not be empty
+func getPersonsFilter(in *movies_persons_service.GetPersonsRequest) (repository.PersonsFilter, error) {
+	filter := repository.PersonsFilter{
+		CreatedAfter:  getTimeFromTimestamp(in.CreatedAfter),
+		CreatedBefore: getTimeFromTimestamp(in.CreatedBefore),
+		UpdatedAfter:  getTimeFromTimestamp(in.UpdatedAfter),
+		UpdatedBefore: getTimeFromTimestamp(in.UpdatedBefore),
+	}
+	if !filter.CreatedBefore.IsZero() && !filter.CreatedBefore.After(filter.CreatedAfter) {
+		return repository.PersonsFilter{}, fmt.Errorf("%s error: %w",
+			"created_before must be after created_after", ErrInvalidArgument)
+	}
+	if !filter.UpdatedBefore.IsZero() && !filter.UpdatedBefore.After(filter.UpdatedAfter) {
+		return repository.PersonsFilter{}, fmt.Errorf("%s error: %w",
+			"updated_before must be after updated_after", ErrInvalidArgument)
+	}
+	return filter, nil
+}
+
+func splitScoredPersons(scored []repository.ScoredPerson) ([]repository.Person, []float32) {
+	persons := make([]repository.Person, 0, len(scored))
+	scores := make([]float32, 0, len(scored))
+	for _, p := range scored {
+		persons = append(persons, p.Person)
+		scores = append(scores, p.Score)
+	}
+	return persons, scores
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/Falokut/admin_movies_persons_service/internal/events"
 	"github.com/Falokut/admin_movies_persons_service/internal/repository"
 	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
 	"github.com/Falokut/grpc_errors"
@@ -16,6 +16,7 @@ import (
 	"github.com/opentracing/opentracing-go/ext"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -23,24 +24,40 @@ import (
 
 type MoviesPersonsService struct {
 	movies_persons_service.UnimplementedMoviesPersonsServiceV1Server
-	logger        *logrus.Logger
-	imagesService ImagesService
-	repo          repository.PersonsRepository
-	eventsMQ      events.PersonsEventsMQ
-	errorHandler  errorHandler
+	logger            *logrus.Logger
+	imagesService     ImagesService
+	repo              repository.PersonsRepository
+	idempotencyRepo   repository.IdempotencyRepository
+	idempotencyCfg    IdempotencyConfig
+	importCfg         ImportConfig
+	importPhotoClient *http.Client
+	changesRepo       repository.PersonsChangesRepository
+	changesNotifier   *personsChangesNotifier
+	watchCfg          WatchConfig
+	errorHandler      errorHandler
 }
 
 func NewMoviesPersonsService(logger *logrus.Logger,
 	repo repository.PersonsRepository,
 	imagesService ImagesService,
-	eventsMQ events.PersonsEventsMQ) *MoviesPersonsService {
+	idempotencyRepo repository.IdempotencyRepository,
+	idempotencyCfg IdempotencyConfig,
+	importCfg ImportConfig,
+	changesRepo repository.PersonsChangesRepository,
+	watchCfg WatchConfig) *MoviesPersonsService {
 	errorHandler := newErrorHandler(logger)
 	return &MoviesPersonsService{
-		logger:        logger,
-		repo:          repo,
-		errorHandler:  errorHandler,
-		imagesService: imagesService,
-		eventsMQ:      eventsMQ,
+		logger:            logger,
+		repo:              repo,
+		errorHandler:      errorHandler,
+		imagesService:     imagesService,
+		idempotencyRepo:   idempotencyRepo,
+		idempotencyCfg:    idempotencyCfg,
+		importCfg:         importCfg,
+		importPhotoClient: newImportPhotoClient(importCfg),
+		changesRepo:       changesRepo,
+		changesNotifier:   newPersonsChangesNotifier(watchCfg, logger, changesRepo),
+		watchCfg:          watchCfg,
 	}
 }
 
@@ -49,33 +66,64 @@ func (s *MoviesPersonsService) GetPersons(ctx context.Context,
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.GetPersons")
 	defer span.Finish()
 
-	offset := in.Limit * (in.Page - 1)
-	if err := validateLimitAndPage(in.Page, in.Limit); err != nil {
+	page, err := getPage(in, repository.OrderByID, false)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+	filter, err := getPersonsFilter(in)
+	if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
 	}
 
 	var persons []repository.Person
-	var err error
+	var redirects map[int32]int32
 	if in.PersonsIDs == "" {
-		persons, err = s.repo.GetAllPersons(ctx, in.Limit, offset)
+		persons, err = s.repo.GetAllPersons(ctx, filter, page)
 	} else {
 		in.PersonsIDs = strings.TrimSpace(strings.ReplaceAll(in.PersonsIDs, `"`, ""))
 		if err := checkParam(in.PersonsIDs); err != nil {
 			return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
 		}
 
-		ids := strings.Split(in.PersonsIDs, ",")
-		persons, err = s.repo.GetPersons(ctx, convertStringsSlice(ids), in.Limit, offset)
+		ids := convertStringsSlice(strings.Split(in.PersonsIDs, ","))
+		persons, err = s.repo.GetPersons(ctx, ids, filter, page)
+		if err == nil {
+			redirects, err = s.repo.GetPersonsRedirects(ctx, ids)
+		}
+	}
+
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
+	} else if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	converted := s.convertPersonsPage(ctx, persons, page, in.OrderBy)
+	converted.RedirectedIDs = redirects
+
+	span.SetTag("grpc.status", codes.OK)
+	return converted, nil
+}
+
+func (s *MoviesPersonsService) GetPerson(ctx context.Context,
+	in *movies_persons_service.GetPersonRequest) (*movies_persons_service.PersonDetails, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.GetPerson")
+	defer span.Finish()
+
+	if in.ID <= 0 {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "", "id must be > 0")
 	}
 
+	person, err := s.repo.GetPerson(ctx, in.ID)
 	if errors.Is(err, repository.ErrNotFound) {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
 	} else if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
 	}
 
+	s.setETag(ctx, person.Version)
 	span.SetTag("grpc.status", codes.OK)
-	return s.convertPersons(ctx, persons), nil
+	return s.convertPersonDetails(ctx, person), nil
 }
 
 func (s *MoviesPersonsService) SearchPerson(ctx context.Context,
@@ -83,8 +131,8 @@ func (s *MoviesPersonsService) SearchPerson(ctx context.Context,
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.SearchPerson")
 	defer span.Finish()
 
-	offset := in.Limit * (in.Page - 1)
-	if err := validateLimitAndPage(in.Page, in.Limit); err != nil {
+	page, err := getPage(in, repository.OrderByID, false)
+	if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
 	}
 
@@ -93,7 +141,7 @@ func (s *MoviesPersonsService) SearchPerson(ctx context.Context,
 		FullnameEN: in.GetFullnameEN(),
 		Birthday:   getTimeFromTimestamp(in.Birthday),
 		Sex:        in.GetSex(),
-	}, in.Limit, offset)
+	}, page)
 
 	switch err {
 	case repository.ErrInvalidArgument:
@@ -102,17 +150,34 @@ func (s *MoviesPersonsService) SearchPerson(ctx context.Context,
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
 	case nil:
 		span.SetTag("grpc.status", codes.OK)
-		return s.convertPersons(ctx, persons), nil
+		return s.convertPersonsPage(ctx, persons, page, in.OrderBy), nil
 	default:
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
 	}
 }
 
 func (s *MoviesPersonsService) UpdatePersonFields(ctx context.Context,
+	in *movies_persons_service.UpdatePersonFieldsRequest) (*emptypb.Empty, error) {
+	return withIdempotency(ctx, s, "UpdatePersonFields", in, func() (*emptypb.Empty, error) {
+		return s.updatePersonFields(ctx, in)
+	})
+}
+
+func (s *MoviesPersonsService) updatePersonFields(ctx context.Context,
 	in *movies_persons_service.UpdatePersonFieldsRequest) (*emptypb.Empty, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx,
 		"MoviesPersonsService.UpdatePersonFields")
 	defer span.Finish()
+	ctx = withActor(ctx)
+
+	fields, err := getUpdateFields(in)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+	expectedVersion, err := getExpectedVersion(ctx, in.ExpectedVersion)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
 
 	exists, err := s.IsPersonWithIDExists(ctx,
 		&movies_persons_service.IsPersonWithIDExistsRequest{PersonID: in.ID})
@@ -132,28 +197,83 @@ func (s *MoviesPersonsService) UpdatePersonFields(ctx context.Context,
 		}
 	}
 
-	err = s.repo.UpdatePerson(ctx, in.ID, repository.UpdatePersonParam{
+	version, err := s.repo.UpdatePerson(ctx, in.ID, repository.UpdatePersonParam{
 		FullnameRU: in.GetFullnameRU(),
 		FullnameEN: in.GetFullnameEN(),
 		Birthday:   getTimeFromTimestamp(in.GetBirthday()),
 		Sex:        in.GetSex(),
 		PhotoID:    photoID,
-	}, true)
+	}, fields, expectedVersion)
 
 	if errors.Is(err, repository.ErrNotFound) {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
+	} else if errors.Is(err, repository.ErrVersionMismatch) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrVersionMismatch, "")
 	} else if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
 	}
 
+	s.setETag(ctx, version)
 	span.SetTag("grpc.status", codes.OK)
 	return &emptypb.Empty{}, nil
 }
 
 func (s *MoviesPersonsService) DeletePersons(ctx context.Context,
+	in *movies_persons_service.DeletePersonsRequest) (*movies_persons_service.DeletePersonsResponce, error) {
+	return withIdempotency(ctx, s, "DeletePersons", in, func() (*movies_persons_service.DeletePersonsResponce, error) {
+		return s.deletePersons(ctx, in)
+	})
+}
+
+func (s *MoviesPersonsService) deletePersons(ctx context.Context,
 	in *movies_persons_service.DeletePersonsRequest) (*movies_persons_service.DeletePersonsResponce, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.DeletePerson")
 	defer span.Finish()
+	ctx = withActor(ctx)
+
+	in.PersonsIDs = strings.TrimSpace(strings.ReplaceAll(in.PersonsIDs, `"`, ""))
+	if in.PersonsIDs == "" {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, "persons_ids mustn't be empty")
+	} else if err := checkParam(in.PersonsIDs); err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+	ids := convertStringsSlice(strings.Split(in.PersonsIDs, ","))
+
+	var expectedVersions map[int32]int32
+	if len(in.ExpectedVersions) > 0 {
+		if len(in.ExpectedVersions) != len(ids) {
+			return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument,
+				"expected_versions must contain the version for each of the persons_ids")
+		}
+		expectedVersions = make(map[int32]int32, len(ids))
+		for i, id := range ids {
+			expectedVersions[id] = in.ExpectedVersions[i]
+		}
+	}
+
+	deletedIDs, err := s.repo.DeletePersons(ctx, ids, expectedVersions)
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrVersionMismatch, "")
+	} else if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return &movies_persons_service.DeletePersonsResponce{DeletedPersonIDs: deletedIDs}, nil
+}
+
+func (s *MoviesPersonsService) RestorePersons(ctx context.Context,
+	in *movies_persons_service.RestorePersonsRequest) (*movies_persons_service.RestorePersonsResponse, error) {
+	return withIdempotency(ctx, s, "RestorePersons", in, func() (*movies_persons_service.RestorePersonsResponse, error) {
+		return s.restorePersons(ctx, in)
+	})
+}
+
+func (s *MoviesPersonsService) restorePersons(ctx context.Context,
+	in *movies_persons_service.RestorePersonsRequest) (*movies_persons_service.RestorePersonsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.RestorePersons")
+	defer span.Finish()
+	ctx = withActor(ctx)
 
 	in.PersonsIDs = strings.TrimSpace(strings.ReplaceAll(in.PersonsIDs, `"`, ""))
 	if in.PersonsIDs == "" {
@@ -163,21 +283,35 @@ func (s *MoviesPersonsService) DeletePersons(ctx context.Context,
 	}
 	ids := strings.Split(in.PersonsIDs, ",")
 
-	deletedIDs, err := s.repo.DeletePersons(ctx, convertStringsSlice(ids))
+	restoredIDs, err := s.repo.RestorePersons(ctx, convertStringsSlice(ids))
 	if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
 	}
 
-	go func(s *MoviesPersonsService, deletedIDs []int32) {
-		for _, id := range deletedIDs {
-			err := s.eventsMQ.PersonDeleted(context.Background(), id)
-			if err != nil {
-				s.logger.Error(err)
-			}
-		}
-	}(s, deletedIDs)
 	span.SetTag("grpc.status", codes.OK)
-	return &movies_persons_service.DeletePersonsResponce{DeletedPersonIDs: deletedIDs}, nil
+	return &movies_persons_service.RestorePersonsResponse{RestoredPersonsIDs: restoredIDs}, nil
+}
+
+func (s *MoviesPersonsService) ReemitPersonsEvents(ctx context.Context,
+	in *movies_persons_service.ReemitPersonsEventsRequest) (*movies_persons_service.ReemitPersonsEventsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.ReemitPersonsEvents")
+	defer span.Finish()
+
+	in.PersonsIDs = strings.TrimSpace(strings.ReplaceAll(in.PersonsIDs, `"`, ""))
+	if in.PersonsIDs == "" {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, "persons_ids mustn't be empty")
+	} else if err := checkParam(in.PersonsIDs); err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
+	ids := strings.Split(in.PersonsIDs, ",")
+
+	reemittedIDs, err := s.repo.ReemitPersonsEvents(ctx, convertStringsSlice(ids))
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+
+	span.SetTag("grpc.status", codes.OK)
+	return &movies_persons_service.ReemitPersonsEventsResponse{ReemittedPersonsIDs: reemittedIDs}, nil
 }
 
 func (s *MoviesPersonsService) IsPersonWithIDExists(ctx context.Context,
@@ -215,9 +349,17 @@ func (s *MoviesPersonsService) IsPersonExists(ctx context.Context,
 }
 
 func (s *MoviesPersonsService) CreatePerson(ctx context.Context,
+	in *movies_persons_service.CreatePersonRequest) (*movies_persons_service.CreatePersonResponce, error) {
+	return withIdempotency(ctx, s, "CreatePerson", in, func() (*movies_persons_service.CreatePersonResponce, error) {
+		return s.createPerson(ctx, in)
+	})
+}
+
+func (s *MoviesPersonsService) createPerson(ctx context.Context,
 	in *movies_persons_service.CreatePersonRequest) (*movies_persons_service.CreatePersonResponce, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.CreatePerson")
 	defer span.Finish()
+	ctx = withActor(ctx)
 
 	res, err := s.IsPersonExists(ctx, &movies_persons_service.IsPersonExistsRequest{
 		FullnameRU: &in.FullnameRU,
@@ -234,6 +376,22 @@ func (s *MoviesPersonsService) CreatePerson(ctx context.Context,
 		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrAlreadyExists, "", msg)
 	}
 
+	if !in.IgnorePotentialDuplicates {
+		duplicates, err := s.findPotentialDuplicates(ctx, duplicatesSearchParam{
+			FullnameRU: in.GetFullnameRU(),
+			FullnameEN: in.GetFullnameEN(),
+			Birthday:   getTimeFromTimestamp(in.GetBirthday()),
+			Sex:        in.GetSex(),
+		}, 0, defaultDuplicateMinScore)
+		if err != nil {
+			return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		} else if len(duplicates) > 0 {
+			msg := "finded potential duplicates of the person, " +
+				"if the person isn't one of them, set ignore_potential_duplicates to create the person"
+			return nil, s.errorHandler.createDuplicatesErrorResponceWithSpan(span, ErrAlreadyExists, msg, duplicates)
+		}
+	}
+
 	var photoID = ""
 	if len(in.Photo) > 0 {
 		photoID, err = s.imagesService.UploadImage(ctx, in.Photo)
@@ -244,7 +402,7 @@ func (s *MoviesPersonsService) CreatePerson(ctx context.Context,
 		}
 	}
 
-	id, err := s.repo.CreatePerson(ctx, repository.CreatePersonParam{
+	id, existingIDs, err := s.repo.CreatePerson(ctx, repository.CreatePersonParam{
 		FullnameRU: in.GetFullnameRU(),
 		FullnameEN: in.GetFullnameEN(),
 		Birthday:   getTimeFromTimestamp(in.GetBirthday()),
@@ -253,6 +411,15 @@ func (s *MoviesPersonsService) CreatePerson(ctx context.Context,
 	})
 	if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	} else if len(existingIDs) > 0 {
+		// the person was created concurrently after the check
+		if photoID != "" {
+			if err := s.imagesService.DeleteImage(ctx, photoID); err != nil {
+				s.logger.Errorf("%v while deleting photo %s of the not created person", err, photoID)
+			}
+		}
+		msg := fmt.Sprintf("finded persons with ids: %s", formatSlice(existingIDs))
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrAlreadyExists, "", msg)
 	}
 
 	span.SetTag("grpc.status", codes.OK)
@@ -279,9 +446,22 @@ func convertStringsSlice(str []string) []int32 {
 }
 
 func (s *MoviesPersonsService) UpdatePerson(ctx context.Context,
+	in *movies_persons_service.UpdatePersonRequest) (*emptypb.Empty, error) {
+	return withIdempotency(ctx, s, "UpdatePerson", in, func() (*emptypb.Empty, error) {
+		return s.updatePerson(ctx, in)
+	})
+}
+
+func (s *MoviesPersonsService) updatePerson(ctx context.Context,
 	in *movies_persons_service.UpdatePersonRequest) (*emptypb.Empty, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MoviesPersonsService.UpdatePerson")
 	defer span.Finish()
+	ctx = withActor(ctx)
+
+	expectedVersion, err := getExpectedVersion(ctx, in.ExpectedVersion)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
+	}
 
 	exists, err := s.IsPersonWithIDExists(ctx,
 		&movies_persons_service.IsPersonWithIDExistsRequest{PersonID: in.ID})
@@ -300,20 +480,26 @@ func (s *MoviesPersonsService) UpdatePerson(ctx context.Context,
 			return nil, err
 		}
 	}
-	err = s.repo.UpdatePerson(ctx, in.ID, repository.UpdatePersonParam{
+	version, err := s.repo.UpdatePerson(ctx, in.ID, repository.UpdatePersonParam{
 		FullnameRU: in.GetFullnameRU(),
 		FullnameEN: in.GetFullnameEN(),
-		Birthday:   in.GetBirthday().AsTime(),
+		Birthday:   getTimeFromTimestamp(in.GetBirthday()),
 		Sex:        in.GetSex(),
 		PhotoID:    photoID,
-	}, false)
+	}, repository.AllPersonFields, expectedVersion)
 
 	if errors.Is(err, repository.ErrNotFound) {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
+	} else if errors.Is(err, repository.ErrVersionMismatch) {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrVersionMismatch, "")
+	} else if errors.Is(err, repository.ErrInvalidArgument) {
+		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "",
+			"fullname_ru mustn't be empty")
 	} else if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
 	}
 
+	s.setETag(ctx, version)
 	span.SetTag("grpc.status", codes.OK)
 	return &emptypb.Empty{}, nil
 }
@@ -328,20 +514,33 @@ func (s *MoviesPersonsService) SearchPersonByName(ctx context.Context,
 		return nil, s.errorHandler.createExtendedErrorResponceWithSpan(span, ErrInvalidArgument, "", "name mustn't be empty")
 	}
 
-	offset := in.Limit * (in.Page - 1)
-	if err := validateLimitAndPage(in.Page, in.Limit); err != nil {
+	page, err := getSearchPersonByNamePage(in)
+	if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument, err.Error())
 	}
 
-	persons, err := s.repo.SearchPersonByName(ctx, in.Name, in.Limit, offset)
+	var persons []repository.Person
+	var scores []float32
+	if in.Mode == movies_persons_service.SearchPersonByNameRequest_FUZZY {
+		var scored []repository.ScoredPerson
+		scored, err = s.repo.FuzzySearchPersonByName(ctx, in.Name, page)
+		persons, scores = splitScoredPersons(scored)
+	} else {
+		persons, err = s.repo.SearchPersonByName(ctx, in.Name, page)
+	}
 	if errors.Is(err, repository.ErrNotFound) {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrNotFound, "")
 	} else if err != nil {
 		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
 	}
 
+	converted := s.convertPersonsPage(ctx, persons, page, in.OrderBy)
+	for i, score := range scores {
+		converted.Persons[persons[i].ID].Score = score
+	}
+
 	span.SetTag("grpc.status", codes.OK)
-	return s.convertPersons(ctx, persons), nil
+	return converted, nil
 }
 
 func (s *MoviesPersonsService) IsPersonsExists(ctx context.Context,
@@ -386,8 +585,23 @@ func (s *MoviesPersonsService) IsPersonsExists(ctx context.Context,
 		return &movies_persons_service.IsPersonsExistsResponse{PersonsExists: true}, nil
 	}
 
+	redirects, err := s.repo.GetPersonsRedirects(ctx, notFoundedIDs)
+	if err != nil {
+		return nil, s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+	}
+	var notExistIDs = make([]int32, 0, len(notFoundedIDs))
+	for _, id := range notFoundedIDs {
+		if _, ok := redirects[id]; !ok {
+			notExistIDs = append(notExistIDs, id)
+		}
+	}
+
 	span.SetTag("grpc.status", codes.OK)
-	return &movies_persons_service.IsPersonsExistsResponse{PersonsExists: false, NotExistIDs: notFoundedIDs}, nil
+	return &movies_persons_service.IsPersonsExistsResponse{
+		PersonsExists: len(notExistIDs) == 0,
+		NotExistIDs:   notExistIDs,
+		RedirectedIDs: redirects,
+	}, nil
 }
 
 func (s *MoviesPersonsService) convertPersons(ctx context.Context,
@@ -395,22 +609,61 @@ func (s *MoviesPersonsService) convertPersons(ctx context.Context,
 	converted := &movies_persons_service.Persons{}
 	converted.Persons = make(map[string]*movies_persons_service.Person, len(persons))
 	for _, p := range persons {
-		birthday := ""
-		if p.Birthday.Valid {
-			birthday = p.Birthday.Time.Format("2006-01-02")
-		}
-		converted.Persons[p.ID] = &movies_persons_service.Person{
-			FullnameRU: p.FullnameRU,
-			FullnameEN: p.FullnameEN.String,
-			Birthday:   birthday,
-			Sex:        p.Sex.String,
-			PhotoUrl:   s.imagesService.GetPictureURL(ctx, p.PhotoID.String),
-		}
+		converted.Persons[p.ID] = s.convertPerson(ctx, p)
 	}
 
 	return converted
 }
 
+func (s *MoviesPersonsService) convertPersonsPage(ctx context.Context,
+	persons []repository.Person, page repository.Page, orderBy string) *movies_persons_service.Persons {
+	converted := s.convertPersons(ctx, persons)
+	converted.NextPageToken = getNextPageToken(persons, page, orderBy)
+	return converted
+}
+
+// Metadata key with id of the account, that makes changes
+const ActorMetadataKey = "x-account-id"
+
+func (s *MoviesPersonsService) convertPersonDetails(ctx context.Context,
+	p repository.Person) *movies_persons_service.PersonDetails {
+	converted := s.convertPerson(ctx, p)
+	return &movies_persons_service.PersonDetails{
+		ID:         converted.ID,
+		FullnameRU: converted.FullnameRU,
+		FullnameEN: converted.FullnameEN,
+		Birthday:   converted.Birthday,
+		Sex:        converted.Sex,
+		PhotoID:    p.PhotoID.String,
+		PhotoUrl:   converted.PhotoUrl,
+		Version:    converted.Version,
+		CreatedAt:  converted.CreatedAt,
+		UpdatedAt:  converted.UpdatedAt,
+		CreatedBy:  converted.CreatedBy,
+		UpdatedBy:  converted.UpdatedBy,
+	}
+}
+
+// Returns the copy of the ctx with the actor from the incoming metadata
+func withActor(ctx context.Context) context.Context {
+	if actor := getActor(ctx); actor != "" {
+		return repository.ContextWithActor(ctx, actor)
+	}
+	return ctx
+}
+
+// Returns the actor from the incoming metadata, empty if it isn't specified
+func getActor(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if actor := md.Get(ActorMetadataKey); len(actor) > 0 {
+		return actor[0]
+	}
+	return ""
+}
+
 func getTimeFromTimestamp(t *timestamppb.Timestamp) time.Time {
 	if t != nil {
 		return t.AsTime()
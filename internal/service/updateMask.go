@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+)
+
+// Update mask paths, both proto and json names of the fields are accepted
+var updateMaskFields = map[string]repository.PersonField{
+	"fullnameRU":  repository.FullnameRUField,
+	"fullname_ru": repository.FullnameRUField,
+	"fullnameEN":  repository.FullnameENField,
+	"fullname_en": repository.FullnameENField,
+	"birthday":    repository.BirthdayField,
+	"sex":         repository.SexField,
+	"photo":       repository.PhotoIDField,
+}
+
+// Returns fields of the person to update. If update mask is empty,
+// only specified fields with the non default values are updated
+func getUpdateFields(in *movies_persons_service.UpdatePersonFieldsRequest) ([]repository.PersonField, error) {
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		fields := make([]repository.PersonField, 0, len(repository.AllPersonFields))
+		if in.GetFullnameRU() != "" {
+			fields = append(fields, repository.FullnameRUField)
+		}
+		if in.GetFullnameEN() != "" {
+			fields = append(fields, repository.FullnameENField)
+		}
+		if !getTimeFromTimestamp(in.GetBirthday()).IsZero() {
+			fields = append(fields, repository.BirthdayField)
+		}
+		if in.GetSex() != "" {
+			fields = append(fields, repository.SexField)
+		}
+		if len(in.GetPhoto()) > 0 {
+			fields = append(fields, repository.PhotoIDField)
+		}
+		return fields, nil
+	}
+
+	fields := make([]repository.PersonField, 0, len(paths))
+	added := make(map[repository.PersonField]struct{}, len(paths))
+	for _, path := range paths {
+		field, ok := updateMaskFields[path]
+		if !ok {
+			return nil, fmt.Errorf("%s error: %w", "unknown update_mask path "+path, ErrInvalidArgument)
+		}
+		if _, ok := added[field]; ok {
+			continue
+		}
+		if field == repository.FullnameRUField && in.GetFullnameRU() == "" {
+			return nil, fmt.Errorf("%s error: %w", "fullname_ru can't be null", ErrInvalidArgument)
+		}
+		added[field] = struct{}{}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
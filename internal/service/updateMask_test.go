@@ -0,0 +1,79 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetUpdateFields(t *testing.T) {
+	name, empty := "Андрей Тарковский", ""
+	birthday := timestamppb.New(time.Date(1932, 4, 4, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name    string
+		in      *movies_persons_service.UpdatePersonFieldsRequest
+		want    []repository.PersonField
+		wantErr error
+	}{
+		{"without mask only set fields",
+			&movies_persons_service.UpdatePersonFieldsRequest{FullnameRU: &name, Birthday: birthday},
+			[]repository.PersonField{repository.FullnameRUField, repository.BirthdayField}, nil},
+		{"without mask empty values are skipped",
+			&movies_persons_service.UpdatePersonFieldsRequest{FullnameEN: &empty, Sex: &empty},
+			[]repository.PersonField{}, nil},
+		{"mask clears birthday",
+			&movies_persons_service.UpdatePersonFieldsRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"birthday"}}},
+			[]repository.PersonField{repository.BirthdayField}, nil},
+		{"mask with json and proto names",
+			&movies_persons_service.UpdatePersonFieldsRequest{FullnameRU: &name,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"fullname_en", "fullnameRU", "photo"}}},
+			[]repository.PersonField{repository.FullnameENField, repository.FullnameRUField, repository.PhotoIDField}, nil},
+		{"repeated paths are skipped",
+			&movies_persons_service.UpdatePersonFieldsRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"sex", "sex", "fullnameEN", "fullname_en"}}},
+			[]repository.PersonField{repository.SexField, repository.FullnameENField}, nil},
+		{"unknown path",
+			&movies_persons_service.UpdatePersonFieldsRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"photo_id"}}},
+			nil, ErrInvalidArgument},
+		{"fullname_ru can't be cleared",
+			&movies_persons_service.UpdatePersonFieldsRequest{FullnameRU: &empty,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"fullname_ru"}}},
+			nil, ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		got, err := getUpdateFields(tt.in)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got fields %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGetTimeFromTimestamp(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *timestamppb.Timestamp
+		want time.Time
+	}{
+		// birthday, that isn't set, is cleared, not set to the unix epoch
+		{"nil", nil, time.Time{}},
+		{"epoch", timestamppb.New(time.Unix(0, 0)), time.Unix(0, 0).UTC()},
+		{"date", timestamppb.New(time.Date(1932, 4, 4, 0, 0, 0, 0, time.UTC)), time.Date(1932, 4, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := getTimeFromTimestamp(tt.in); !got.Equal(tt.want) || got.IsZero() != tt.want.IsZero() {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -13,6 +13,10 @@ func validateLimitAndPage(page, limit int32) error {
 	if page <= 0 {
 		return fmt.Errorf("%s error: %w", "page must be > 0", ErrInvalidArgument)
 	}
+	return validateLimit(limit)
+}
+
+func validateLimit(limit int32) error {
 	if limit < 10 || limit > 100 {
 		return fmt.Errorf("%s error: %w", "limit must in range [10;100]", ErrInvalidArgument)
 	}
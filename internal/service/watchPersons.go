@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Falokut/admin_movies_persons_service/internal/repository"
+	movies_persons_service "github.com/Falokut/admin_movies_persons_service/pkg/admin_movies_persons_service/v1/protos"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WatchConfig struct {
+	// Delay between checks of the new persons changes
+	PollInterval time.Duration
+	// Max number of changes read per query
+	BatchSize int32
+}
+
+func (c WatchConfig) Validate() error {
+	if c.PollInterval <= 0 {
+		return errors.New("poll_interval must be positive")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("batch_size must be positive")
+	}
+	return nil
+}
+
+func (s *MoviesPersonsService) WatchPersons(in *movies_persons_service.WatchPersonsRequest,
+	stream movies_persons_service.MoviesPersonsServiceV1_WatchPersonsServer) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "MoviesPersonsService.WatchPersons")
+	defer span.Finish()
+
+	if in.SinceRevision != nil && in.GetSinceRevision() < 0 {
+		return s.errorHandler.createErrorResponceWithSpan(span, ErrInvalidArgument,
+			"since_revision mustn't be negative")
+	}
+
+	// subscribed before the revision is read, so changes made after the read aren't missed
+	notifications, unsubscribe := s.changesNotifier.subscribe()
+	defer unsubscribe()
+
+	revision := in.GetSinceRevision()
+	if in.SinceRevision == nil {
+		var err error
+		revision, err = s.changesRepo.GetLastPersonsChangeRevision(ctx)
+		if err != nil {
+			return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		}
+	}
+
+	for {
+		// compaction is checked on every batch, because the changes can be removed while they are replayed
+		changes, err := s.changesRepo.GetPersonsChanges(ctx, revision, s.watchCfg.BatchSize)
+		if ctx.Err() != nil {
+			// the watcher is disconnected
+			span.SetTag("grpc.status", codes.OK)
+			return nil
+		} else if errors.Is(err, repository.ErrChangesCompacted) {
+			return s.errorHandler.createErrorResponceWithSpan(span, ErrRevisionCompacted,
+				"changes after the revision are removed, persons must be reloaded")
+		} else if err != nil {
+			return s.errorHandler.createErrorResponceWithSpan(span, ErrInternal, err.Error())
+		}
+
+		for _, change := range changes {
+			if err = stream.Send(s.convertPersonChange(ctx, change)); err != nil {
+				return err
+			}
+			revision = change.Revision
+		}
+		if len(changes) == int(s.watchCfg.BatchSize) {
+			// there may be more changes, don't wait
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			span.SetTag("grpc.status", codes.OK)
+			return nil
+		case <-notifications:
+		}
+	}
+}
+
+func (s *MoviesPersonsService) convertPersonChange(ctx context.Context,
+	change repository.PersonChange) *movies_persons_service.PersonChange {
+	return &movies_persons_service.PersonChange{
+		Revision:  change.Revision,
+		Type:      getPersonChangeType(change.Action),
+		Person:    s.convertPerson(ctx, change.Person),
+		ChangedAt: timestamppb.New(change.ChangedAt),
+	}
+}
+
+func getPersonChangeType(action string) movies_persons_service.PersonChange_Type {
+	switch action {
+	case repository.PersonCreatedAction:
+		return movies_persons_service.PersonChange_CREATED
+	case repository.PersonDeletedAction, repository.PersonMergedIntoAction:
+		return movies_persons_service.PersonChange_DELETED
+	case repository.PersonRestoredAction:
+		return movies_persons_service.PersonChange_RESTORED
+	default:
+		return movies_persons_service.PersonChange_UPDATED
+	}
+}
+
+// personsChangesNotifier polls the last revision of the persons changes while there are watchers
+// and notifies them about the new changes, so the database is polled once for all watchers.
+type personsChangesNotifier struct {
+	cfg    WatchConfig
+	logger *logrus.Logger
+	repo   repository.PersonsChangesRepository
+
+	mu       sync.Mutex
+	watchers map[chan struct{}]struct{}
+	// stops polling, nil if there are no watchers
+	stop context.CancelFunc
+}
+
+func newPersonsChangesNotifier(cfg WatchConfig, logger *logrus.Logger,
+	repo repository.PersonsChangesRepository) *personsChangesNotifier {
+	return &personsChangesNotifier{
+		cfg:      cfg,
+		logger:   logger,
+		repo:     repo,
+		watchers: make(map[chan struct{}]struct{}),
+	}
+}
+
+// Returns the channel, that receives the value, when new changes are made,
+// unsubscribe must be called, when the watcher stops
+func (n *personsChangesNotifier) subscribe() (notifications <-chan struct{}, unsubscribe func()) {
+	ch := make(chan struct{}, 1)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.stop == nil {
+		var ctx context.Context
+		ctx, n.stop = context.WithCancel(context.Background())
+		go n.run(ctx)
+	}
+	n.watchers[ch] = struct{}{}
+
+	return ch, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		delete(n.watchers, ch)
+		if len(n.watchers) == 0 && n.stop != nil {
+			n.stop()
+			n.stop = nil
+		}
+	}
+}
+
+// Run blocks until ctx is done.
+func (n *personsChangesNotifier) run(ctx context.Context) {
+	ticker := time.NewTicker(n.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastRevision int64
+	for {
+		last, err := n.repo.GetLastPersonsChangeRevision(ctx)
+		if err != nil && ctx.Err() == nil {
+			n.logger.Errorf("error while checking persons changes: %v", err)
+		} else if err == nil && last != lastRevision {
+			lastRevision = last
+			n.notify()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *personsChangesNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.watchers {
+		// watcher, that isn't notified yet, will read all new changes
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
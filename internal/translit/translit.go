@@ -0,0 +1,216 @@
+// Package translit converts persons names between Cyrillic and Latin scripts.
+package translit
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ISO 9:1995, also known as GOST 7.79-2000 system A
+var iso9 = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë", 'ж': "ž", 'з': "z",
+	'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "c", 'ч': "č", 'ш': "š", 'щ': "ŝ",
+	'ъ': "ʺ", 'ы': "y", 'ь': "ʹ", 'э': "è", 'ю': "û", 'я': "â",
+}
+
+// GOST 7.79-2000 system B
+var gostB = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "x", 'ц': "cz", 'ч': "ch", 'ш': "sh", 'щ': "shh",
+	'ъ': "``", 'ы': "y'", 'ь': "`", 'э': "e`", 'ю': "yu", 'я': "ya",
+}
+
+// ICAO Doc 9303, used in the russian passports
+var icao = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "ie", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+}
+
+// Informal transliteration, that is common in the english names, for example Tarkovsky or Andrey
+var informal = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// Informal name endings, that are transliterated as a whole
+var informalEndings = map[string]string{
+	"ий": "y",
+	"ый": "y",
+}
+
+// Latin letters combinations in the order of the matching priority
+var informalReverse = []struct{ latin, cyrillic string }{
+	{"shch", "щ"}, {"sch", "щ"}, {"zh", "ж"}, {"kh", "х"}, {"ch", "ч"}, {"sh", "ш"}, {"ts", "ц"},
+	{"yu", "ю"}, {"ya", "я"}, {"yo", "ё"}, {"ye", "е"},
+}
+
+var icaoReverse = []struct{ latin, cyrillic string }{
+	{"shch", "щ"}, {"zh", "ж"}, {"kh", "х"}, {"ch", "ч"}, {"sh", "ш"}, {"ts", "ц"}, {"iu", "ю"}, {"ia", "я"},
+}
+
+var latinLetters = map[rune]string{
+	'a': "а", 'b': "б", 'c': "к", 'd': "д", 'e': "е", 'f': "ф", 'g': "г", 'h': "х", 'i': "и",
+	'j': "й", 'k': "к", 'l': "л", 'm': "м", 'n': "н", 'o': "о", 'p': "п", 'q': "к", 'r': "р",
+	's': "с", 't': "т", 'u': "у", 'v': "в", 'w': "в", 'x': "кс", 'y': "ы", 'z': "з",
+}
+
+// Returns lower cased name, normalized name and its transliterations to the other script, without duplicates.
+// Name in Cyrillic is transliterated by ISO 9, GOST 7.79 system B, ICAO and informally,
+// name in Latin is transliterated back informally and by ICAO.
+func Variants(name string) []string {
+	variants := []string{strings.ToLower(strings.TrimSpace(name))}
+	name = Normalize(name)
+	variants = append(variants, name)
+
+	if hasScript(name, unicode.Cyrillic) {
+		variants = append(variants,
+			toLatin(name, iso9, nil),
+			toLatin(name, gostB, nil),
+			toLatin(name, icao, nil),
+			toLatin(name, informal, informalEndings))
+	}
+	if hasScript(name, unicode.Latin) {
+		variants = append(variants, informalToCyrillic(name), icaoToCyrillic(name))
+	}
+
+	return unique(variants)
+}
+
+// Returns lower cased name with ё replaced by е, punctuation replaced by spaces and collapsed spaces
+func Normalize(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "ё", "е")
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) && r != '\'' {
+			return ' '
+		}
+		return r
+	}, name)
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func hasScript(s string, script *unicode.RangeTable) bool {
+	for _, r := range s {
+		if unicode.Is(script, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func toLatin(s string, table map[rune]string, endings map[string]string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if i+1 < len(runes) && isWordEnd(runes, i+2) {
+			if ending, ok := endings[string(runes[i:i+2])]; ok {
+				b.WriteString(ending)
+				i++
+				continue
+			}
+		}
+
+		if latin, ok := table[runes[i]]; ok {
+			b.WriteString(latin)
+		} else {
+			b.WriteRune(runes[i])
+		}
+	}
+	return b.String()
+}
+
+func informalToCyrillic(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if n, cyrillic := matchCombination(runes[i:], informalReverse); n > 0 {
+			b.WriteString(cyrillic)
+			i += n - 1
+			continue
+		}
+
+		if runes[i] == 'y' {
+			switch {
+			case i > 0 && isLatinVowel(runes[i-1]):
+				b.WriteString("й")
+			case i > 0 && isWordEnd(runes, i+1):
+				// Russian orthography uses и after к, г and х
+				if strings.ContainsRune("kgh", runes[i-1]) {
+					b.WriteString("ий")
+				} else {
+					b.WriteString("ый")
+				}
+			default:
+				b.WriteString("ы")
+			}
+			continue
+		}
+		writeLatinLetter(&b, runes[i])
+	}
+	return b.String()
+}
+
+func icaoToCyrillic(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if n, cyrillic := matchCombination(runes[i:], icaoReverse); n > 0 {
+			b.WriteString(cyrillic)
+			i += n - 1
+			continue
+		}
+
+		// й is transliterated as i, so word ending ii is ий, yi is ый and ei is ей
+		if runes[i] == 'i' && i > 0 && isWordEnd(runes, i+1) && isLatinVowel(runes[i-1]) {
+			b.WriteString("й")
+			continue
+		}
+		writeLatinLetter(&b, runes[i])
+	}
+	return b.String()
+}
+
+func matchCombination(runes []rune, combinations []struct{ latin, cyrillic string }) (int, string) {
+	for _, c := range combinations {
+		n := len(c.latin)
+		if len(runes) >= n && string(runes[:n]) == c.latin {
+			return n, c.cyrillic
+		}
+	}
+	return 0, ""
+}
+
+func writeLatinLetter(b *strings.Builder, r rune) {
+	if cyrillic, ok := latinLetters[r]; ok {
+		b.WriteString(cyrillic)
+	} else {
+		b.WriteRune(r)
+	}
+}
+
+func isLatinVowel(r rune) bool {
+	return strings.ContainsRune("aeiouy", r)
+}
+
+// Returns true if i is the end of the word in runes
+func isWordEnd(runes []rune, i int) bool {
+	return i >= len(runes) || !unicode.IsLetter(runes[i])
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,144 @@
+package translit
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVariants(t *testing.T) {
+	tests := []struct {
+		name string
+		// variants, that must be returned among others
+		want []string
+	}{
+		{"Тарковский", []string{"тарковский", "tarkovsky", "tarkovskii", "tarkovskij"}},
+		{"Андрей Тарковский", []string{"андрей тарковский", "andrey tarkovsky", "andrei tarkovskii"}},
+		{"Tarkovsky", []string{"tarkovsky", "тарковский"}},
+		{"Andrey Tarkovsky", []string{"andrey tarkovsky", "андрей тарковский"}},
+		{"Andrei Tarkovskii", []string{"andrei tarkovskii", "андрей тарковский"}},
+		{"Пётр Фёдоров", []string{"пётр фёдоров", "петр федоров", "petr fedorov"}},
+		{"Белый", []string{"белый", "bely", "belyj"}},
+		{"Bely", []string{"белый"}},
+		{"Dmitrii", []string{"дмитрий"}},
+		{"Nikolay", []string{"николай"}},
+		{"Yuriy", []string{"юрий"}},
+		{"  ANDREY  ", []string{"andrey", "андрей"}},
+	}
+
+	for _, tt := range tests {
+		got := Variants(tt.name)
+		for _, want := range tt.want {
+			if !slices.Contains(got, want) {
+				t.Errorf("Variants(%q) = %q, want it to contain %q", tt.name, got, want)
+			}
+		}
+	}
+}
+
+func TestVariantsAreUnique(t *testing.T) {
+	for _, name := range []string{"Тарковский", "Andrey", "иван"} {
+		got := Variants(name)
+		seen := make(map[string]bool, len(got))
+		for _, v := range got {
+			if seen[v] {
+				t.Errorf("Variants(%q) = %q, %q is repeated", name, got, v)
+			}
+			seen[v] = true
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Андрей Тарковский", "андрей тарковский"},
+		{"Ёлкин Пётр", "елкин петр"},
+		{"  Jean-Paul   Belmondo ", "jean paul belmondo"},
+		{"Ivanov, Ivan.", "ivanov ivan"},
+		{"O'Brien", "o'brien"},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.name); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestToLatin(t *testing.T) {
+	tests := []struct {
+		name     string
+		table    map[rune]string
+		endings  map[string]string
+		cyrillic string
+		want     string
+	}{
+		{"informal ий ending", informal, informalEndings, "тарковский", "tarkovsky"},
+		{"informal ый ending", informal, informalEndings, "белый", "bely"},
+		{"informal ий inside the word", informal, informalEndings, "бийск", "biysk"},
+		{"informal й", informal, informalEndings, "андрей", "andrey"},
+		{"informal ё", informal, informalEndings, "семён", "semyon"},
+		{"icao ий ending", icao, nil, "тарковский", "tarkovskii"},
+		{"icao й", icao, nil, "андрей", "andrei"},
+		{"icao ё", icao, nil, "семён", "semen"},
+		{"icao soft sign", icao, nil, "игорь", "igor"},
+		{"gost b", gostB, nil, "щукин", "shhukin"},
+		{"iso 9", iso9, nil, "щукин", "ŝukin"},
+	}
+
+	for _, tt := range tests {
+		if got := toLatin(tt.cyrillic, tt.table, tt.endings); got != tt.want {
+			t.Errorf("%s: toLatin(%q) = %q, want %q", tt.name, tt.cyrillic, got, tt.want)
+		}
+	}
+}
+
+func TestInformalToCyrillic(t *testing.T) {
+	tests := []struct {
+		latin string
+		want  string
+	}{
+		{"tarkovsky", "тарковский"},
+		{"andrey", "андрей"},
+		{"bely", "белый"},
+		{"nikolay", "николай"},
+		{"yuriy", "юрий"},
+		{"alexey", "алексей"},
+		// y after the consonant inside the word
+		{"vysotsky", "высоцкий"},
+		{"yelena", "елена"},
+		{"zhukov", "жуков"},
+		{"khrushchev", "хрущев"},
+	}
+
+	for _, tt := range tests {
+		if got := informalToCyrillic(tt.latin); got != tt.want {
+			t.Errorf("informalToCyrillic(%q) = %q, want %q", tt.latin, got, tt.want)
+		}
+	}
+}
+
+func TestICAOToCyrillic(t *testing.T) {
+	tests := []struct {
+		latin string
+		want  string
+	}{
+		{"tarkovskii", "тарковский"},
+		{"andrei", "андрей"},
+		{"dmitrii", "дмитрий"},
+		{"belyi", "белый"},
+		// i after the consonant isn't й
+		{"ivan", "иван"},
+		{"nikita", "никита"},
+		{"iuliia", "юлия"},
+		{"tsvetaeva", "цветаева"},
+	}
+
+	for _, tt := range tests {
+		if got := icaoToCyrillic(tt.latin); got != tt.want {
+			t.Errorf("icaoToCyrillic(%q) = %q, want %q", tt.latin, got, tt.want)
+		}
+	}
+}
@@ -17,6 +17,5 @@ if [[ ! -z ${ADMIN_SERVICE_PASSWORD} ]]; then
     echo "Admin service user created"
 fi
 
-echo "Running init sql script"
-psql -f 'docker-entrypoint-initdb.d/up.sql'
+# schema is created by the service migrations
 echo "Initialization is complete"
@@ -39,7 +39,7 @@ var file_admin_movies_persons_service_v1_proto_rawDesc = []byte{
 	0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x32, 0x8f, 0x0e, 0x0a, 0x16, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x32, 0xd7, 0x2a, 0x0a, 0x16, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f,
 	0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x31, 0x12, 0x79, 0x0a, 0x0a, 0x47,
 	0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x64, 0x6d, 0x69,
 	0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
@@ -66,28 +66,84 @@ var file_admin_movies_persons_service_v1_proto_rawDesc = []byte{
 	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72,
 	0x73, 0x6f, 0x6e, 0x73, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76,
 	0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x14, 0x49, 0x73, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x12, 0x39, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x61, 0x64,
+	0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x56, 0x32, 0x12, 0x2f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x32,
+	0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x56, 0x32, 0x12, 0x31, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x9d, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x12, 0x37,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x32, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x7b,
+	0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xca, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x22, 0x60, 0x92, 0x41, 0x46, 0x4a, 0x44, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x3d, 0x0a, 0x1e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b,
+	0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b, 0x49,
+	0x44, 0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x14, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x44, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x39, 0x2e, 0x61, 0x64,
 	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f,
 	0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72,
 	0x73, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
-	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b, 0x50, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x2f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x96, 0x01,
-	0x0a, 0x0e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x12, 0x33, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x49, 0x44, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44,
+	0x7d, 0x2f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x0e, 0x49, 0x73, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
 	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
-	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f,
-	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0f, 0x49, 0x73, 0x50, 0x65, 0x72,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x82, 0x02, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x50, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x76,
+	0x92, 0x41, 0x55, 0x4a, 0x53, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x4c, 0x0a, 0x2d, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x19, 0x1a,
+	0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0f, 0x49, 0x73, 0x50, 0x65, 0x72,
 	0x73, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x34, 0x2e, 0x61, 0x64, 0x6d,
 	0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
 	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73,
@@ -97,127 +153,359 @@ var file_admin_movies_persons_service_v1_proto_rawDesc = []byte{
 	0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
 	0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x12, 0xd1, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0xbb, 0x02, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
 	0x72, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x37, 0x2e, 0x61, 0x64, 0x6d,
 	0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
 	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x6a, 0x92, 0x41, 0x46,
-	0x4a, 0x44, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x3d, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20,
-	0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23,
-	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xbe, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x63, 0x92, 0x41, 0x46, 0x4a, 0x44, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12,
-	0x3d, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
-	0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xfd, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xd3, 0x01, 0x92, 0x41,
+	0xae, 0x01, 0x4a, 0x66, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x5f, 0x0a, 0x40, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x44, 0x0a, 0x03, 0x34, 0x30,
+	0x34, 0x12, 0x3d, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0xa8, 0x02, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xcc, 0x01,
+	0x92, 0x41, 0xae, 0x01, 0x4a, 0x44, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x3d, 0x0a, 0x1e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
+	0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x66, 0x0a, 0x03, 0x34, 0x30,
+	0x39, 0x12, 0x5f, 0x0a, 0x40, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x20, 0x69, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xd6, 0x02, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x63, 0x65, 0x22, 0xde, 0x01, 0x92, 0x41, 0xc5, 0x01, 0x4a, 0xc2, 0x01, 0x0a, 0x03,
+	0x34, 0x30, 0x39, 0x12, 0xba, 0x01, 0x0a, 0x9a, 0x01, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x61, 0x6c,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x28, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x61, 0x6c, 0x6c, 0x20,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x65, 0x78, 0x65, 0x70, 0x74, 0x20, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x20, 0x6f,
+	0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x20,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2c, 0x20, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0xa5, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x2e, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x63, 0x65,
-	0x22, 0x85, 0x01, 0x92, 0x41, 0x6d, 0x4a, 0x6b, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x64, 0x0a,
-	0x45, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x28, 0x77,
-	0x68, 0x65, 0x6e, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x65,
-	0x78, 0x65, 0x70, 0x74, 0x20, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x29,
-	0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0xd7, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x64, 0x6d,
+	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0xad, 0x01,
+	0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x31, 0x2e, 0x61, 0x64, 0x6d,
 	0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x63, 0x65, 0x22, 0x5d, 0x92, 0x41, 0x47, 0x4a, 0x45, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12,
-	0x3e, 0x0a, 0x1f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
-	0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x2a, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x73, 0x42, 0xc8, 0x02, 0x5a, 0x26, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76,
+	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x30, 0x01, 0x12, 0x9d, 0x01,
+	0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x30, 0x01, 0x12, 0x93, 0x01,
+	0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x30, 0x01, 0x12, 0x8a, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76,
 	0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x92, 0x41, 0x9c,
-	0x02, 0x12, 0x64, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x73, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x22, 0x3f, 0x0a, 0x07, 0x46, 0x61, 0x6c, 0x6f, 0x6b, 0x75, 0x74, 0x12, 0x1a, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x46, 0x61, 0x6c, 0x6f, 0x6b, 0x75, 0x74, 0x1a, 0x18, 0x74, 0x69, 0x6d, 0x75, 0x72, 0x2e,
-	0x73, 0x69, 0x6e, 0x65, 0x6c, 0x6e, 0x69, 0x6b, 0x40, 0x79, 0x61, 0x6e, 0x64, 0x65, 0x78, 0x2e,
-	0x72, 0x75, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x50,
-	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x49, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
-	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x2e, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x3b, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x34, 0x0a, 0x15, 0x53, 0x6f, 0x6d, 0x65, 0x74,
-	0x68, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x2e,
-	0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01,
+	0x12, 0xac, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x38, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0xc5, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x12, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xca, 0x01, 0x92, 0x41, 0xb3,
+	0x01, 0x4a, 0x45, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x3e, 0x0a, 0x1f, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x19, 0x1a,
+	0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x6a, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12,
+	0x63, 0x0a, 0x44, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x6e, 0x27, 0x74, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74,
+	0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x2a, 0x0b, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xb0, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x65, 0x6d, 0x69, 0x74,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x65,
+	0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x65, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x72,
+	0x65, 0x65, 0x6d, 0x69, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xfa, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x82, 0x01, 0x92, 0x41, 0x63, 0x4a, 0x61, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x5a, 0x0a,
+	0x3b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x19,
+	0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xfa, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7a, 0x92, 0x41, 0x50, 0x4a, 0x4e, 0x0a, 0x03, 0x34,
+	0x30, 0x34, 0x12, 0x47, 0x0a, 0x28, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77,
+	0x68, 0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b,
+	0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x8b, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x3a, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x22, 0x83, 0x01, 0x92, 0x41, 0x54,
+	0x4a, 0x52, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x4b, 0x0a, 0x2c, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44,
+	0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x64, 0x69, 0x66, 0x66,
+	0x12, 0x87, 0x02, 0x0a, 0x18, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x54, 0x6f, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x93, 0x01, 0x92, 0x41, 0x52, 0x4a, 0x50, 0x0a, 0x03, 0x34, 0x30,
+	0x34, 0x12, 0x49, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x38, 0x22, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x7b,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x42, 0xc8, 0x02, 0x5a, 0x26, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x92, 0x41, 0x9c, 0x02, 0x12, 0x64, 0x0a, 0x1c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x20, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x20, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3f, 0x0a, 0x07, 0x46, 0x61, 0x6c,
+	0x6f, 0x6b, 0x75, 0x74, 0x12, 0x1a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x46, 0x61, 0x6c, 0x6f, 0x6b, 0x75, 0x74,
+	0x1a, 0x18, 0x74, 0x69, 0x6d, 0x75, 0x72, 0x2e, 0x73, 0x69, 0x6e, 0x65, 0x6c, 0x6e, 0x69, 0x6b,
+	0x40, 0x79, 0x61, 0x6e, 0x64, 0x65, 0x78, 0x2e, 0x72, 0x75, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a,
+	0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x50, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x49, 0x0a,
+	0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2e, 0x12, 0x1b, 0x0a, 0x19, 0x1a,
+	0x17, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x3b, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
+	0x34, 0x0a, 0x15, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x65, 0x6e,
+	0x74, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x2e, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x23, 0x2f,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x70, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_admin_movies_persons_service_v1_proto_goTypes = []interface{}{
-	(*GetPersonsRequest)(nil),            // 0: admin_movies_persons_service.GetPersonsRequest
-	(*SearchPersonRequest)(nil),          // 1: admin_movies_persons_service.SearchPersonRequest
-	(*SearchPersonByNameRequest)(nil),    // 2: admin_movies_persons_service.SearchPersonByNameRequest
-	(*IsPersonWithIDExistsRequest)(nil),  // 3: admin_movies_persons_service.IsPersonWithIDExistsRequest
-	(*IsPersonExistsRequest)(nil),        // 4: admin_movies_persons_service.IsPersonExistsRequest
-	(*IsPersonsExistsRequest)(nil),       // 5: admin_movies_persons_service.IsPersonsExistsRequest
-	(*UpdatePersonFieldsRequest)(nil),    // 6: admin_movies_persons_service.UpdatePersonFieldsRequest
-	(*UpdatePersonRequest)(nil),          // 7: admin_movies_persons_service.UpdatePersonRequest
-	(*CreatePersonRequest)(nil),          // 8: admin_movies_persons_service.CreatePersonRequest
-	(*DeletePersonsRequest)(nil),         // 9: admin_movies_persons_service.DeletePersonsRequest
-	(*Persons)(nil),                      // 10: admin_movies_persons_service.Persons
-	(*IsPersonWithIDExistsResponse)(nil), // 11: admin_movies_persons_service.IsPersonWithIDExistsResponse
-	(*IsPersonExistsResponse)(nil),       // 12: admin_movies_persons_service.IsPersonExistsResponse
-	(*IsPersonsExistsResponse)(nil),      // 13: admin_movies_persons_service.IsPersonsExistsResponse
-	(*emptypb.Empty)(nil),                // 14: google.protobuf.Empty
-	(*CreatePersonResponce)(nil),         // 15: admin_movies_persons_service.CreatePersonResponce
-	(*DeletePersonsResponce)(nil),        // 16: admin_movies_persons_service.DeletePersonsResponce
+	(*GetPersonsRequest)(nil),               // 0: admin_movies_persons_service.GetPersonsRequest
+	(*SearchPersonRequest)(nil),             // 1: admin_movies_persons_service.SearchPersonRequest
+	(*SearchPersonByNameRequest)(nil),       // 2: admin_movies_persons_service.SearchPersonByNameRequest
+	(*GetPersonRequest)(nil),                // 3: admin_movies_persons_service.GetPersonRequest
+	(*IsPersonWithIDExistsRequest)(nil),     // 4: admin_movies_persons_service.IsPersonWithIDExistsRequest
+	(*IsPersonExistsRequest)(nil),           // 5: admin_movies_persons_service.IsPersonExistsRequest
+	(*FindPotentialDuplicatesRequest)(nil),  // 6: admin_movies_persons_service.FindPotentialDuplicatesRequest
+	(*IsPersonsExistsRequest)(nil),          // 7: admin_movies_persons_service.IsPersonsExistsRequest
+	(*UpdatePersonFieldsRequest)(nil),       // 8: admin_movies_persons_service.UpdatePersonFieldsRequest
+	(*UpdatePersonRequest)(nil),             // 9: admin_movies_persons_service.UpdatePersonRequest
+	(*CreatePersonRequest)(nil),             // 10: admin_movies_persons_service.CreatePersonRequest
+	(*BatchCreatePersonsRequest)(nil),       // 11: admin_movies_persons_service.BatchCreatePersonsRequest
+	(*ImportPersonsRequest)(nil),            // 12: admin_movies_persons_service.ImportPersonsRequest
+	(*ExportPersonsRequest)(nil),            // 13: admin_movies_persons_service.ExportPersonsRequest
+	(*WatchPersonsRequest)(nil),             // 14: admin_movies_persons_service.WatchPersonsRequest
+	(*BatchUpdatePersonsRequest)(nil),       // 15: admin_movies_persons_service.BatchUpdatePersonsRequest
+	(*DeletePersonsRequest)(nil),            // 16: admin_movies_persons_service.DeletePersonsRequest
+	(*RestorePersonsRequest)(nil),           // 17: admin_movies_persons_service.RestorePersonsRequest
+	(*ReemitPersonsEventsRequest)(nil),      // 18: admin_movies_persons_service.ReemitPersonsEventsRequest
+	(*MergePersonsRequest)(nil),             // 19: admin_movies_persons_service.MergePersonsRequest
+	(*ListPersonRevisionsRequest)(nil),      // 20: admin_movies_persons_service.ListPersonRevisionsRequest
+	(*GetPersonRevisionDiffRequest)(nil),    // 21: admin_movies_persons_service.GetPersonRevisionDiffRequest
+	(*RollbackPersonToRevisionRequest)(nil), // 22: admin_movies_persons_service.RollbackPersonToRevisionRequest
+	(*Persons)(nil),                         // 23: admin_movies_persons_service.Persons
+	(*PersonsList)(nil),                     // 24: admin_movies_persons_service.PersonsList
+	(*PersonDetails)(nil),                   // 25: admin_movies_persons_service.PersonDetails
+	(*IsPersonWithIDExistsResponse)(nil),    // 26: admin_movies_persons_service.IsPersonWithIDExistsResponse
+	(*IsPersonExistsResponse)(nil),          // 27: admin_movies_persons_service.IsPersonExistsResponse
+	(*PotentialDuplicates)(nil),             // 28: admin_movies_persons_service.PotentialDuplicates
+	(*IsPersonsExistsResponse)(nil),         // 29: admin_movies_persons_service.IsPersonsExistsResponse
+	(*emptypb.Empty)(nil),                   // 30: google.protobuf.Empty
+	(*CreatePersonResponce)(nil),            // 31: admin_movies_persons_service.CreatePersonResponce
+	(*BatchCreatePersonsResponse)(nil),      // 32: admin_movies_persons_service.BatchCreatePersonsResponse
+	(*BatchCreatePersonResult)(nil),         // 33: admin_movies_persons_service.BatchCreatePersonResult
+	(*ExportPersonsChunk)(nil),              // 34: admin_movies_persons_service.ExportPersonsChunk
+	(*PersonChange)(nil),                    // 35: admin_movies_persons_service.PersonChange
+	(*BatchUpdatePersonsResponse)(nil),      // 36: admin_movies_persons_service.BatchUpdatePersonsResponse
+	(*DeletePersonsResponce)(nil),           // 37: admin_movies_persons_service.DeletePersonsResponce
+	(*RestorePersonsResponse)(nil),          // 38: admin_movies_persons_service.RestorePersonsResponse
+	(*ReemitPersonsEventsResponse)(nil),     // 39: admin_movies_persons_service.ReemitPersonsEventsResponse
+	(*MergePersonsResponse)(nil),            // 40: admin_movies_persons_service.MergePersonsResponse
+	(*PersonRevisions)(nil),                 // 41: admin_movies_persons_service.PersonRevisions
+	(*PersonRevisionDiff)(nil),              // 42: admin_movies_persons_service.PersonRevisionDiff
 }
 var file_admin_movies_persons_service_v1_proto_depIdxs = []int32{
 	0,  // 0: admin_movies_persons_service.moviesPersonsServiceV1.GetPersons:input_type -> admin_movies_persons_service.GetPersonsRequest
 	1,  // 1: admin_movies_persons_service.moviesPersonsServiceV1.SearchPerson:input_type -> admin_movies_persons_service.SearchPersonRequest
 	2,  // 2: admin_movies_persons_service.moviesPersonsServiceV1.SearchPersonByName:input_type -> admin_movies_persons_service.SearchPersonByNameRequest
-	3,  // 3: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonWithIDExists:input_type -> admin_movies_persons_service.IsPersonWithIDExistsRequest
-	4,  // 4: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonExists:input_type -> admin_movies_persons_service.IsPersonExistsRequest
-	5,  // 5: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonsExists:input_type -> admin_movies_persons_service.IsPersonsExistsRequest
-	6,  // 6: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePersonFields:input_type -> admin_movies_persons_service.UpdatePersonFieldsRequest
-	7,  // 7: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePerson:input_type -> admin_movies_persons_service.UpdatePersonRequest
-	8,  // 8: admin_movies_persons_service.moviesPersonsServiceV1.CreatePerson:input_type -> admin_movies_persons_service.CreatePersonRequest
-	9,  // 9: admin_movies_persons_service.moviesPersonsServiceV1.DeletePersons:input_type -> admin_movies_persons_service.DeletePersonsRequest
-	10, // 10: admin_movies_persons_service.moviesPersonsServiceV1.GetPersons:output_type -> admin_movies_persons_service.Persons
-	10, // 11: admin_movies_persons_service.moviesPersonsServiceV1.SearchPerson:output_type -> admin_movies_persons_service.Persons
-	10, // 12: admin_movies_persons_service.moviesPersonsServiceV1.SearchPersonByName:output_type -> admin_movies_persons_service.Persons
-	11, // 13: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonWithIDExists:output_type -> admin_movies_persons_service.IsPersonWithIDExistsResponse
-	12, // 14: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonExists:output_type -> admin_movies_persons_service.IsPersonExistsResponse
-	13, // 15: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonsExists:output_type -> admin_movies_persons_service.IsPersonsExistsResponse
-	14, // 16: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePersonFields:output_type -> google.protobuf.Empty
-	14, // 17: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePerson:output_type -> google.protobuf.Empty
-	15, // 18: admin_movies_persons_service.moviesPersonsServiceV1.CreatePerson:output_type -> admin_movies_persons_service.CreatePersonResponce
-	16, // 19: admin_movies_persons_service.moviesPersonsServiceV1.DeletePersons:output_type -> admin_movies_persons_service.DeletePersonsResponce
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	0,  // 3: admin_movies_persons_service.moviesPersonsServiceV1.GetPersonsV2:input_type -> admin_movies_persons_service.GetPersonsRequest
+	1,  // 4: admin_movies_persons_service.moviesPersonsServiceV1.SearchPersonV2:input_type -> admin_movies_persons_service.SearchPersonRequest
+	2,  // 5: admin_movies_persons_service.moviesPersonsServiceV1.SearchPersonByNameV2:input_type -> admin_movies_persons_service.SearchPersonByNameRequest
+	3,  // 6: admin_movies_persons_service.moviesPersonsServiceV1.GetPerson:input_type -> admin_movies_persons_service.GetPersonRequest
+	4,  // 7: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonWithIDExists:input_type -> admin_movies_persons_service.IsPersonWithIDExistsRequest
+	5,  // 8: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonExists:input_type -> admin_movies_persons_service.IsPersonExistsRequest
+	6,  // 9: admin_movies_persons_service.moviesPersonsServiceV1.FindPotentialDuplicates:input_type -> admin_movies_persons_service.FindPotentialDuplicatesRequest
+	7,  // 10: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonsExists:input_type -> admin_movies_persons_service.IsPersonsExistsRequest
+	8,  // 11: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePersonFields:input_type -> admin_movies_persons_service.UpdatePersonFieldsRequest
+	9,  // 12: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePerson:input_type -> admin_movies_persons_service.UpdatePersonRequest
+	10, // 13: admin_movies_persons_service.moviesPersonsServiceV1.CreatePerson:input_type -> admin_movies_persons_service.CreatePersonRequest
+	11, // 14: admin_movies_persons_service.moviesPersonsServiceV1.BatchCreatePersons:input_type -> admin_movies_persons_service.BatchCreatePersonsRequest
+	10, // 15: admin_movies_persons_service.moviesPersonsServiceV1.BatchCreatePersonsStream:input_type -> admin_movies_persons_service.CreatePersonRequest
+	12, // 16: admin_movies_persons_service.moviesPersonsServiceV1.ImportPersons:input_type -> admin_movies_persons_service.ImportPersonsRequest
+	13, // 17: admin_movies_persons_service.moviesPersonsServiceV1.ExportPersons:input_type -> admin_movies_persons_service.ExportPersonsRequest
+	14, // 18: admin_movies_persons_service.moviesPersonsServiceV1.WatchPersons:input_type -> admin_movies_persons_service.WatchPersonsRequest
+	15, // 19: admin_movies_persons_service.moviesPersonsServiceV1.BatchUpdatePersons:input_type -> admin_movies_persons_service.BatchUpdatePersonsRequest
+	16, // 20: admin_movies_persons_service.moviesPersonsServiceV1.DeletePersons:input_type -> admin_movies_persons_service.DeletePersonsRequest
+	17, // 21: admin_movies_persons_service.moviesPersonsServiceV1.RestorePersons:input_type -> admin_movies_persons_service.RestorePersonsRequest
+	18, // 22: admin_movies_persons_service.moviesPersonsServiceV1.ReemitPersonsEvents:input_type -> admin_movies_persons_service.ReemitPersonsEventsRequest
+	19, // 23: admin_movies_persons_service.moviesPersonsServiceV1.MergePersons:input_type -> admin_movies_persons_service.MergePersonsRequest
+	20, // 24: admin_movies_persons_service.moviesPersonsServiceV1.ListPersonRevisions:input_type -> admin_movies_persons_service.ListPersonRevisionsRequest
+	21, // 25: admin_movies_persons_service.moviesPersonsServiceV1.GetPersonRevisionDiff:input_type -> admin_movies_persons_service.GetPersonRevisionDiffRequest
+	22, // 26: admin_movies_persons_service.moviesPersonsServiceV1.RollbackPersonToRevision:input_type -> admin_movies_persons_service.RollbackPersonToRevisionRequest
+	23, // 27: admin_movies_persons_service.moviesPersonsServiceV1.GetPersons:output_type -> admin_movies_persons_service.Persons
+	23, // 28: admin_movies_persons_service.moviesPersonsServiceV1.SearchPerson:output_type -> admin_movies_persons_service.Persons
+	23, // 29: admin_movies_persons_service.moviesPersonsServiceV1.SearchPersonByName:output_type -> admin_movies_persons_service.Persons
+	24, // 30: admin_movies_persons_service.moviesPersonsServiceV1.GetPersonsV2:output_type -> admin_movies_persons_service.PersonsList
+	24, // 31: admin_movies_persons_service.moviesPersonsServiceV1.SearchPersonV2:output_type -> admin_movies_persons_service.PersonsList
+	24, // 32: admin_movies_persons_service.moviesPersonsServiceV1.SearchPersonByNameV2:output_type -> admin_movies_persons_service.PersonsList
+	25, // 33: admin_movies_persons_service.moviesPersonsServiceV1.GetPerson:output_type -> admin_movies_persons_service.PersonDetails
+	26, // 34: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonWithIDExists:output_type -> admin_movies_persons_service.IsPersonWithIDExistsResponse
+	27, // 35: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonExists:output_type -> admin_movies_persons_service.IsPersonExistsResponse
+	28, // 36: admin_movies_persons_service.moviesPersonsServiceV1.FindPotentialDuplicates:output_type -> admin_movies_persons_service.PotentialDuplicates
+	29, // 37: admin_movies_persons_service.moviesPersonsServiceV1.IsPersonsExists:output_type -> admin_movies_persons_service.IsPersonsExistsResponse
+	30, // 38: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePersonFields:output_type -> google.protobuf.Empty
+	30, // 39: admin_movies_persons_service.moviesPersonsServiceV1.UpdatePerson:output_type -> google.protobuf.Empty
+	31, // 40: admin_movies_persons_service.moviesPersonsServiceV1.CreatePerson:output_type -> admin_movies_persons_service.CreatePersonResponce
+	32, // 41: admin_movies_persons_service.moviesPersonsServiceV1.BatchCreatePersons:output_type -> admin_movies_persons_service.BatchCreatePersonsResponse
+	33, // 42: admin_movies_persons_service.moviesPersonsServiceV1.BatchCreatePersonsStream:output_type -> admin_movies_persons_service.BatchCreatePersonResult
+	33, // 43: admin_movies_persons_service.moviesPersonsServiceV1.ImportPersons:output_type -> admin_movies_persons_service.BatchCreatePersonResult
+	34, // 44: admin_movies_persons_service.moviesPersonsServiceV1.ExportPersons:output_type -> admin_movies_persons_service.ExportPersonsChunk
+	35, // 45: admin_movies_persons_service.moviesPersonsServiceV1.WatchPersons:output_type -> admin_movies_persons_service.PersonChange
+	36, // 46: admin_movies_persons_service.moviesPersonsServiceV1.BatchUpdatePersons:output_type -> admin_movies_persons_service.BatchUpdatePersonsResponse
+	37, // 47: admin_movies_persons_service.moviesPersonsServiceV1.DeletePersons:output_type -> admin_movies_persons_service.DeletePersonsResponce
+	38, // 48: admin_movies_persons_service.moviesPersonsServiceV1.RestorePersons:output_type -> admin_movies_persons_service.RestorePersonsResponse
+	39, // 49: admin_movies_persons_service.moviesPersonsServiceV1.ReemitPersonsEvents:output_type -> admin_movies_persons_service.ReemitPersonsEventsResponse
+	40, // 50: admin_movies_persons_service.moviesPersonsServiceV1.MergePersons:output_type -> admin_movies_persons_service.MergePersonsResponse
+	41, // 51: admin_movies_persons_service.moviesPersonsServiceV1.ListPersonRevisions:output_type -> admin_movies_persons_service.PersonRevisions
+	42, // 52: admin_movies_persons_service.moviesPersonsServiceV1.GetPersonRevisionDiff:output_type -> admin_movies_persons_service.PersonRevisionDiff
+	30, // 53: admin_movies_persons_service.moviesPersonsServiceV1.RollbackPersonToRevision:output_type -> google.protobuf.Empty
+	27, // [27:54] is the sub-list for method output_type
+	0,  // [0:27] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
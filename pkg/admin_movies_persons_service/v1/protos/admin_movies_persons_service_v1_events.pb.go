@@ -0,0 +1,773 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.24.3
+// source: admin_movies_persons_service_v1_events.proto
+
+package protos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Envelope for all events, published by the service.
+// Must stay the first message in the file, the schema registry wire format refers to it by index 0.
+type EventEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unique event id, uuid
+	EventID string `protobuf:"bytes,1,opt,name=eventID,json=event_id,proto3" json:"eventID,omitempty"`
+	// person_created, person_updated, person_deleted or person_merged, same as the topic name
+	EventType string `protobuf:"bytes,2,opt,name=eventType,json=event_type,proto3" json:"eventType,omitempty"`
+	// payload schema version, incremented on incompatible payload changes
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// when the change was committed into the database
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurredAt,json=occurred_at,proto3" json:"occurredAt,omitempty"`
+	// name of the service, that produced the event
+	Producer string `protobuf:"bytes,5,opt,name=producer,proto3" json:"producer,omitempty"`
+	// tracing context of the request, that caused the event
+	TraceContext map[string]string `protobuf:"bytes,6,rep,name=traceContext,json=trace_context,proto3" json:"traceContext,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Payload:
+	//	*EventEnvelope_PersonCreated
+	//	*EventEnvelope_PersonUpdated
+	//	*EventEnvelope_PersonDeleted
+	//	*EventEnvelope_PersonMerged
+	Payload isEventEnvelope_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *EventEnvelope) Reset() {
+	*x = EventEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEnvelope) ProtoMessage() {}
+
+func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
+func (*EventEnvelope) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEnvelope) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetProducer() string {
+	if x != nil {
+		return x.Producer
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
+func (m *EventEnvelope) GetPayload() isEventEnvelope_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPersonCreated() *PersonCreatedEvent {
+	if x, ok := x.GetPayload().(*EventEnvelope_PersonCreated); ok {
+		return x.PersonCreated
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPersonUpdated() *PersonUpdatedEvent {
+	if x, ok := x.GetPayload().(*EventEnvelope_PersonUpdated); ok {
+		return x.PersonUpdated
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPersonDeleted() *PersonDeletedEvent {
+	if x, ok := x.GetPayload().(*EventEnvelope_PersonDeleted); ok {
+		return x.PersonDeleted
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPersonMerged() *PersonMergedEvent {
+	if x, ok := x.GetPayload().(*EventEnvelope_PersonMerged); ok {
+		return x.PersonMerged
+	}
+	return nil
+}
+
+type isEventEnvelope_Payload interface {
+	isEventEnvelope_Payload()
+}
+
+type EventEnvelope_PersonCreated struct {
+	PersonCreated *PersonCreatedEvent `protobuf:"bytes,7,opt,name=personCreated,json=person_created,proto3,oneof"`
+}
+
+type EventEnvelope_PersonUpdated struct {
+	PersonUpdated *PersonUpdatedEvent `protobuf:"bytes,8,opt,name=personUpdated,json=person_updated,proto3,oneof"`
+}
+
+type EventEnvelope_PersonDeleted struct {
+	PersonDeleted *PersonDeletedEvent `protobuf:"bytes,9,opt,name=personDeleted,json=person_deleted,proto3,oneof"`
+}
+
+type EventEnvelope_PersonMerged struct {
+	PersonMerged *PersonMergedEvent `protobuf:"bytes,10,opt,name=personMerged,json=person_merged,proto3,oneof"`
+}
+
+func (*EventEnvelope_PersonCreated) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PersonUpdated) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PersonDeleted) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PersonMerged) isEventEnvelope_Payload() {}
+
+type PersonEventData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         int32   `protobuf:"varint,1,opt,name=ID,json=id,proto3" json:"ID,omitempty"`
+	FullnameRU string  `protobuf:"bytes,2,opt,name=fullnameRU,json=fullname_ru,proto3" json:"fullnameRU,omitempty"`
+	FullnameEN *string `protobuf:"bytes,3,opt,name=fullnameEN,json=fullname_en,proto3,oneof" json:"fullnameEN,omitempty"`
+	// formatted as YYYY-MM-DD
+	Birthday *string `protobuf:"bytes,4,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
+	Sex      *string `protobuf:"bytes,5,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+	PhotoID  *string `protobuf:"bytes,6,opt,name=photoID,json=photo_id,proto3,oneof" json:"photoID,omitempty"`
+}
+
+func (x *PersonEventData) Reset() {
+	*x = PersonEventData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonEventData) ProtoMessage() {}
+
+func (x *PersonEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonEventData.ProtoReflect.Descriptor instead.
+func (*PersonEventData) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PersonEventData) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PersonEventData) GetFullnameRU() string {
+	if x != nil {
+		return x.FullnameRU
+	}
+	return ""
+}
+
+func (x *PersonEventData) GetFullnameEN() string {
+	if x != nil && x.FullnameEN != nil {
+		return *x.FullnameEN
+	}
+	return ""
+}
+
+func (x *PersonEventData) GetBirthday() string {
+	if x != nil && x.Birthday != nil {
+		return *x.Birthday
+	}
+	return ""
+}
+
+func (x *PersonEventData) GetSex() string {
+	if x != nil && x.Sex != nil {
+		return *x.Sex
+	}
+	return ""
+}
+
+func (x *PersonEventData) GetPhotoID() string {
+	if x != nil && x.PhotoID != nil {
+		return *x.PhotoID
+	}
+	return ""
+}
+
+type PersonCreatedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Person *PersonEventData `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+}
+
+func (x *PersonCreatedEvent) Reset() {
+	*x = PersonCreatedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonCreatedEvent) ProtoMessage() {}
+
+func (x *PersonCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonCreatedEvent.ProtoReflect.Descriptor instead.
+func (*PersonCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PersonCreatedEvent) GetPerson() *PersonEventData {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type PersonUpdatedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonID int32 `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+	// json names of the changed fields, all fields for the snapshot
+	ChangedFields []string         `protobuf:"bytes,2,rep,name=changedFields,json=changed_fields,proto3" json:"changedFields,omitempty"`
+	Before        *PersonEventData `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	After         *PersonEventData `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	// true for the events sent by ReemitPersonsEvents, the person isn't changed, before and after are the same
+	// current state of the person, that must be applied as a whole
+	Snapshot bool `protobuf:"varint,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *PersonUpdatedEvent) Reset() {
+	*x = PersonUpdatedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonUpdatedEvent) ProtoMessage() {}
+
+func (x *PersonUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*PersonUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PersonUpdatedEvent) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+func (x *PersonUpdatedEvent) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *PersonUpdatedEvent) GetBefore() *PersonEventData {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PersonUpdatedEvent) GetAfter() *PersonEventData {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *PersonUpdatedEvent) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+type PersonDeletedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonID int32 `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+}
+
+func (x *PersonDeletedEvent) Reset() {
+	*x = PersonDeletedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonDeletedEvent) ProtoMessage() {}
+
+func (x *PersonDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonDeletedEvent.ProtoReflect.Descriptor instead.
+func (*PersonDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PersonDeletedEvent) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+// Source persons are removed, references to them must be replaced with the target person id
+type PersonMergedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceIDs []int32 `protobuf:"varint,1,rep,packed,name=sourceIDs,json=source_ids,proto3" json:"sourceIDs,omitempty"`
+	TargetID  int32   `protobuf:"varint,2,opt,name=targetID,json=target_id,proto3" json:"targetID,omitempty"`
+	// target person after the merge
+	Target *PersonEventData `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *PersonMergedEvent) Reset() {
+	*x = PersonMergedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonMergedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonMergedEvent) ProtoMessage() {}
+
+func (x *PersonMergedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonMergedEvent.ProtoReflect.Descriptor instead.
+func (*PersonMergedEvent) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PersonMergedEvent) GetSourceIDs() []int32 {
+	if x != nil {
+		return x.SourceIDs
+	}
+	return nil
+}
+
+func (x *PersonMergedEvent) GetTargetID() int32 {
+	if x != nil {
+		return x.TargetID
+	}
+	return 0
+}
+
+func (x *PersonMergedEvent) GetTarget() *PersonEventData {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+var File_admin_movies_persons_service_v1_events_proto protoreflect.FileDescriptor
+
+var file_admin_movies_persons_service_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x05,
+	0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x0c,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x59, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x0d, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x56, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75, 0x6c,
+	0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75,
+	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x03, 0x73, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x07, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x65, 0x78, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x49, 0x44, 0x22, 0x5b, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x22, 0x80, 0x02, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x08,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x45, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x31, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x08, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x11,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x12, 0x1b, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x45, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x42, 0x28, 0x5a, 0x26, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_admin_movies_persons_service_v1_events_proto_rawDescOnce sync.Once
+	file_admin_movies_persons_service_v1_events_proto_rawDescData = file_admin_movies_persons_service_v1_events_proto_rawDesc
+)
+
+func file_admin_movies_persons_service_v1_events_proto_rawDescGZIP() []byte {
+	file_admin_movies_persons_service_v1_events_proto_rawDescOnce.Do(func() {
+		file_admin_movies_persons_service_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_movies_persons_service_v1_events_proto_rawDescData)
+	})
+	return file_admin_movies_persons_service_v1_events_proto_rawDescData
+}
+
+var file_admin_movies_persons_service_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_admin_movies_persons_service_v1_events_proto_goTypes = []interface{}{
+	(*EventEnvelope)(nil),         // 0: admin_movies_persons_service.EventEnvelope
+	(*PersonEventData)(nil),       // 1: admin_movies_persons_service.PersonEventData
+	(*PersonCreatedEvent)(nil),    // 2: admin_movies_persons_service.PersonCreatedEvent
+	(*PersonUpdatedEvent)(nil),    // 3: admin_movies_persons_service.PersonUpdatedEvent
+	(*PersonDeletedEvent)(nil),    // 4: admin_movies_persons_service.PersonDeletedEvent
+	(*PersonMergedEvent)(nil),     // 5: admin_movies_persons_service.PersonMergedEvent
+	nil,                           // 6: admin_movies_persons_service.EventEnvelope.TraceContextEntry
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_admin_movies_persons_service_v1_events_proto_depIdxs = []int32{
+	7,  // 0: admin_movies_persons_service.EventEnvelope.occurredAt:type_name -> google.protobuf.Timestamp
+	6,  // 1: admin_movies_persons_service.EventEnvelope.traceContext:type_name -> admin_movies_persons_service.EventEnvelope.TraceContextEntry
+	2,  // 2: admin_movies_persons_service.EventEnvelope.personCreated:type_name -> admin_movies_persons_service.PersonCreatedEvent
+	3,  // 3: admin_movies_persons_service.EventEnvelope.personUpdated:type_name -> admin_movies_persons_service.PersonUpdatedEvent
+	4,  // 4: admin_movies_persons_service.EventEnvelope.personDeleted:type_name -> admin_movies_persons_service.PersonDeletedEvent
+	5,  // 5: admin_movies_persons_service.EventEnvelope.personMerged:type_name -> admin_movies_persons_service.PersonMergedEvent
+	1,  // 6: admin_movies_persons_service.PersonCreatedEvent.person:type_name -> admin_movies_persons_service.PersonEventData
+	1,  // 7: admin_movies_persons_service.PersonUpdatedEvent.before:type_name -> admin_movies_persons_service.PersonEventData
+	1,  // 8: admin_movies_persons_service.PersonUpdatedEvent.after:type_name -> admin_movies_persons_service.PersonEventData
+	1,  // 9: admin_movies_persons_service.PersonMergedEvent.target:type_name -> admin_movies_persons_service.PersonEventData
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_admin_movies_persons_service_v1_events_proto_init() }
+func file_admin_movies_persons_service_v1_events_proto_init() {
+	if File_admin_movies_persons_service_v1_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_admin_movies_persons_service_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonEventData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonCreatedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonUpdatedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonDeletedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonMergedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_admin_movies_persons_service_v1_events_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*EventEnvelope_PersonCreated)(nil),
+		(*EventEnvelope_PersonUpdated)(nil),
+		(*EventEnvelope_PersonDeleted)(nil),
+		(*EventEnvelope_PersonMerged)(nil),
+	}
+	file_admin_movies_persons_service_v1_events_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_admin_movies_persons_service_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_admin_movies_persons_service_v1_events_proto_goTypes,
+		DependencyIndexes: file_admin_movies_persons_service_v1_events_proto_depIdxs,
+		MessageInfos:      file_admin_movies_persons_service_v1_events_proto_msgTypes,
+	}.Build()
+	File_admin_movies_persons_service_v1_events_proto = out.File
+	file_admin_movies_persons_service_v1_events_proto_rawDesc = nil
+	file_admin_movies_persons_service_v1_events_proto_goTypes = nil
+	file_admin_movies_persons_service_v1_events_proto_depIdxs = nil
+}
@@ -25,14 +25,59 @@ const _ = grpc.SupportPackageIsVersion7
 type MoviesPersonsServiceV1Client interface {
 	GetPersons(ctx context.Context, in *GetPersonsRequest, opts ...grpc.CallOption) (*Persons, error)
 	SearchPerson(ctx context.Context, in *SearchPersonRequest, opts ...grpc.CallOption) (*Persons, error)
+	// Name is also matched with its Cyrillic-Latin transliterations
 	SearchPersonByName(ctx context.Context, in *SearchPersonByNameRequest, opts ...grpc.CallOption) (*Persons, error)
+	// Same as GetPersons, but returns persons as an ordered list, empty list if nothing found
+	GetPersonsV2(ctx context.Context, in *GetPersonsRequest, opts ...grpc.CallOption) (*PersonsList, error)
+	// Same as SearchPerson, but returns persons as an ordered list, empty list if nothing found
+	SearchPersonV2(ctx context.Context, in *SearchPersonRequest, opts ...grpc.CallOption) (*PersonsList, error)
+	// Same as SearchPersonByName, but returns persons as an ordered list, empty list if nothing found
+	SearchPersonByNameV2(ctx context.Context, in *SearchPersonByNameRequest, opts ...grpc.CallOption) (*PersonsList, error)
+	// Returns the person, if the id is redirected, returns the person, it was redirected to.
+	// Must be declared before IsPersonExists, the handler, registered later, is matched first,
+	// so /v1/person/exists isn't matched by /v1/person/{ID}
+	GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*PersonDetails, error)
 	IsPersonWithIDExists(ctx context.Context, in *IsPersonWithIDExistsRequest, opts ...grpc.CallOption) (*IsPersonWithIDExistsResponse, error)
+	// Names are matched case insensitive with both person names and with their Cyrillic-Latin transliterations
 	IsPersonExists(ctx context.Context, in *IsPersonExistsRequest, opts ...grpc.CallOption) (*IsPersonExistsResponse, error)
+	// Returns persons, that are similar to the person, names, transliterations and birthdays proximity are taken into account
+	FindPotentialDuplicates(ctx context.Context, in *FindPotentialDuplicatesRequest, opts ...grpc.CallOption) (*PotentialDuplicates, error)
 	IsPersonsExists(ctx context.Context, in *IsPersonsExistsRequest, opts ...grpc.CallOption) (*IsPersonsExistsResponse, error)
 	UpdatePersonFields(ctx context.Context, in *UpdatePersonFieldsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponce, error)
+	// Validates and creates persons, results are returned in the same order as the persons in the request
+	BatchCreatePersons(ctx context.Context, in *BatchCreatePersonsRequest, opts ...grpc.CallOption) (*BatchCreatePersonsResponse, error)
+	// Creates persons in best effort mode as they are received,
+	// result is sent for each person, index is the number of the person in the stream
+	BatchCreatePersonsStream(ctx context.Context, opts ...grpc.CallOption) (MoviesPersonsServiceV1_BatchCreatePersonsStreamClient, error)
+	// Imports persons from the csv or jsonl file, sent in chunks, rows are validated as in CreatePerson
+	// and created in batches, result is sent for each row, index is the line of the row in the file
+	ImportPersons(ctx context.Context, opts ...grpc.CallOption) (MoviesPersonsServiceV1_ImportPersonsClient, error)
+	// Streams not deleted persons ordered by id in the chosen format, the file is split into chunks.
+	// Over REST the file is returned as is with the content type of the format
+	ExportPersons(ctx context.Context, in *ExportPersonsRequest, opts ...grpc.CallOption) (MoviesPersonsServiceV1_ExportPersonsClient, error)
+	// Streams changes of the persons with the revisions greater than since_revision, then streams new changes as they are made.
+	// Over REST the changes are sent as the server-sent events, the Last-Event-ID header can be used instead of since_revision
+	WatchPersons(ctx context.Context, in *WatchPersonsRequest, opts ...grpc.CallOption) (MoviesPersonsServiceV1_WatchPersonsClient, error)
+	// Applies partial updates in one transaction, missing persons and invalid updates are skipped,
+	// unless all_or_nothing is set, in that case nothing is updated
+	BatchUpdatePersons(ctx context.Context, in *BatchUpdatePersonsRequest, opts ...grpc.CallOption) (*BatchUpdatePersonsResponse, error)
+	// Marks persons as deleted, deleted persons are removed after the retention period
 	DeletePersons(ctx context.Context, in *DeletePersonsRequest, opts ...grpc.CallOption) (*DeletePersonsResponce, error)
+	// Restores persons, that were deleted, but not removed yet
+	RestorePersons(ctx context.Context, in *RestorePersonsRequest, opts ...grpc.CallOption) (*RestorePersonsResponse, error)
+	// Sends person_updated events with the current state of the not deleted persons,
+	// events are marked as the snapshots, before and after of them are the same, used to resync the events consumers
+	ReemitPersonsEvents(ctx context.Context, in *ReemitPersonsEventsRequest, opts ...grpc.CallOption) (*ReemitPersonsEventsResponse, error)
+	// Copies chosen fields into the target person and removes the source persons,
+	// source persons ids are redirected to the target person
+	MergePersons(ctx context.Context, in *MergePersonsRequest, opts ...grpc.CallOption) (*MergePersonsResponse, error)
+	// Returns person revisions starting from the latest
+	ListPersonRevisions(ctx context.Context, in *ListPersonRevisionsRequest, opts ...grpc.CallOption) (*PersonRevisions, error)
+	GetPersonRevisionDiff(ctx context.Context, in *GetPersonRevisionDiffRequest, opts ...grpc.CallOption) (*PersonRevisionDiff, error)
+	// Sets person fields to the values from the revision, rollback is stored as a new revision
+	RollbackPersonToRevision(ctx context.Context, in *RollbackPersonToRevisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type moviesPersonsServiceV1Client struct {
@@ -70,6 +115,42 @@ func (c *moviesPersonsServiceV1Client) SearchPersonByName(ctx context.Context, i
 	return out, nil
 }
 
+func (c *moviesPersonsServiceV1Client) GetPersonsV2(ctx context.Context, in *GetPersonsRequest, opts ...grpc.CallOption) (*PersonsList, error) {
+	out := new(PersonsList)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/GetPersonsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) SearchPersonV2(ctx context.Context, in *SearchPersonRequest, opts ...grpc.CallOption) (*PersonsList, error) {
+	out := new(PersonsList)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/SearchPersonV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) SearchPersonByNameV2(ctx context.Context, in *SearchPersonByNameRequest, opts ...grpc.CallOption) (*PersonsList, error) {
+	out := new(PersonsList)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/SearchPersonByNameV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*PersonDetails, error) {
+	out := new(PersonDetails)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/GetPerson", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *moviesPersonsServiceV1Client) IsPersonWithIDExists(ctx context.Context, in *IsPersonWithIDExistsRequest, opts ...grpc.CallOption) (*IsPersonWithIDExistsResponse, error) {
 	out := new(IsPersonWithIDExistsResponse)
 	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/IsPersonWithIDExists", in, out, opts...)
@@ -88,6 +169,15 @@ func (c *moviesPersonsServiceV1Client) IsPersonExists(ctx context.Context, in *I
 	return out, nil
 }
 
+func (c *moviesPersonsServiceV1Client) FindPotentialDuplicates(ctx context.Context, in *FindPotentialDuplicatesRequest, opts ...grpc.CallOption) (*PotentialDuplicates, error) {
+	out := new(PotentialDuplicates)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/FindPotentialDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *moviesPersonsServiceV1Client) IsPersonsExists(ctx context.Context, in *IsPersonsExistsRequest, opts ...grpc.CallOption) (*IsPersonsExistsResponse, error) {
 	out := new(IsPersonsExistsResponse)
 	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/IsPersonsExists", in, out, opts...)
@@ -124,6 +214,150 @@ func (c *moviesPersonsServiceV1Client) CreatePerson(ctx context.Context, in *Cre
 	return out, nil
 }
 
+func (c *moviesPersonsServiceV1Client) BatchCreatePersons(ctx context.Context, in *BatchCreatePersonsRequest, opts ...grpc.CallOption) (*BatchCreatePersonsResponse, error) {
+	out := new(BatchCreatePersonsResponse)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/BatchCreatePersons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) BatchCreatePersonsStream(ctx context.Context, opts ...grpc.CallOption) (MoviesPersonsServiceV1_BatchCreatePersonsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MoviesPersonsServiceV1_ServiceDesc.Streams[0], "/admin_movies_persons_service.moviesPersonsServiceV1/BatchCreatePersonsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &moviesPersonsServiceV1BatchCreatePersonsStreamClient{stream}
+	return x, nil
+}
+
+type MoviesPersonsServiceV1_BatchCreatePersonsStreamClient interface {
+	Send(*CreatePersonRequest) error
+	Recv() (*BatchCreatePersonResult, error)
+	grpc.ClientStream
+}
+
+type moviesPersonsServiceV1BatchCreatePersonsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *moviesPersonsServiceV1BatchCreatePersonsStreamClient) Send(m *CreatePersonRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *moviesPersonsServiceV1BatchCreatePersonsStreamClient) Recv() (*BatchCreatePersonResult, error) {
+	m := new(BatchCreatePersonResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *moviesPersonsServiceV1Client) ImportPersons(ctx context.Context, opts ...grpc.CallOption) (MoviesPersonsServiceV1_ImportPersonsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MoviesPersonsServiceV1_ServiceDesc.Streams[1], "/admin_movies_persons_service.moviesPersonsServiceV1/ImportPersons", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &moviesPersonsServiceV1ImportPersonsClient{stream}
+	return x, nil
+}
+
+type MoviesPersonsServiceV1_ImportPersonsClient interface {
+	Send(*ImportPersonsRequest) error
+	Recv() (*BatchCreatePersonResult, error)
+	grpc.ClientStream
+}
+
+type moviesPersonsServiceV1ImportPersonsClient struct {
+	grpc.ClientStream
+}
+
+func (x *moviesPersonsServiceV1ImportPersonsClient) Send(m *ImportPersonsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *moviesPersonsServiceV1ImportPersonsClient) Recv() (*BatchCreatePersonResult, error) {
+	m := new(BatchCreatePersonResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *moviesPersonsServiceV1Client) ExportPersons(ctx context.Context, in *ExportPersonsRequest, opts ...grpc.CallOption) (MoviesPersonsServiceV1_ExportPersonsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MoviesPersonsServiceV1_ServiceDesc.Streams[2], "/admin_movies_persons_service.moviesPersonsServiceV1/ExportPersons", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &moviesPersonsServiceV1ExportPersonsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MoviesPersonsServiceV1_ExportPersonsClient interface {
+	Recv() (*ExportPersonsChunk, error)
+	grpc.ClientStream
+}
+
+type moviesPersonsServiceV1ExportPersonsClient struct {
+	grpc.ClientStream
+}
+
+func (x *moviesPersonsServiceV1ExportPersonsClient) Recv() (*ExportPersonsChunk, error) {
+	m := new(ExportPersonsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *moviesPersonsServiceV1Client) WatchPersons(ctx context.Context, in *WatchPersonsRequest, opts ...grpc.CallOption) (MoviesPersonsServiceV1_WatchPersonsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MoviesPersonsServiceV1_ServiceDesc.Streams[3], "/admin_movies_persons_service.moviesPersonsServiceV1/WatchPersons", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &moviesPersonsServiceV1WatchPersonsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MoviesPersonsServiceV1_WatchPersonsClient interface {
+	Recv() (*PersonChange, error)
+	grpc.ClientStream
+}
+
+type moviesPersonsServiceV1WatchPersonsClient struct {
+	grpc.ClientStream
+}
+
+func (x *moviesPersonsServiceV1WatchPersonsClient) Recv() (*PersonChange, error) {
+	m := new(PersonChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *moviesPersonsServiceV1Client) BatchUpdatePersons(ctx context.Context, in *BatchUpdatePersonsRequest, opts ...grpc.CallOption) (*BatchUpdatePersonsResponse, error) {
+	out := new(BatchUpdatePersonsResponse)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/BatchUpdatePersons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *moviesPersonsServiceV1Client) DeletePersons(ctx context.Context, in *DeletePersonsRequest, opts ...grpc.CallOption) (*DeletePersonsResponce, error) {
 	out := new(DeletePersonsResponce)
 	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/DeletePersons", in, out, opts...)
@@ -133,20 +367,119 @@ func (c *moviesPersonsServiceV1Client) DeletePersons(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *moviesPersonsServiceV1Client) RestorePersons(ctx context.Context, in *RestorePersonsRequest, opts ...grpc.CallOption) (*RestorePersonsResponse, error) {
+	out := new(RestorePersonsResponse)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/RestorePersons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) ReemitPersonsEvents(ctx context.Context, in *ReemitPersonsEventsRequest, opts ...grpc.CallOption) (*ReemitPersonsEventsResponse, error) {
+	out := new(ReemitPersonsEventsResponse)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/ReemitPersonsEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) MergePersons(ctx context.Context, in *MergePersonsRequest, opts ...grpc.CallOption) (*MergePersonsResponse, error) {
+	out := new(MergePersonsResponse)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/MergePersons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) ListPersonRevisions(ctx context.Context, in *ListPersonRevisionsRequest, opts ...grpc.CallOption) (*PersonRevisions, error) {
+	out := new(PersonRevisions)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/ListPersonRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) GetPersonRevisionDiff(ctx context.Context, in *GetPersonRevisionDiffRequest, opts ...grpc.CallOption) (*PersonRevisionDiff, error) {
+	out := new(PersonRevisionDiff)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/GetPersonRevisionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moviesPersonsServiceV1Client) RollbackPersonToRevision(ctx context.Context, in *RollbackPersonToRevisionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/admin_movies_persons_service.moviesPersonsServiceV1/RollbackPersonToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MoviesPersonsServiceV1Server is the server API for MoviesPersonsServiceV1 service.
 // All implementations must embed UnimplementedMoviesPersonsServiceV1Server
 // for forward compatibility
 type MoviesPersonsServiceV1Server interface {
 	GetPersons(context.Context, *GetPersonsRequest) (*Persons, error)
 	SearchPerson(context.Context, *SearchPersonRequest) (*Persons, error)
+	// Name is also matched with its Cyrillic-Latin transliterations
 	SearchPersonByName(context.Context, *SearchPersonByNameRequest) (*Persons, error)
+	// Same as GetPersons, but returns persons as an ordered list, empty list if nothing found
+	GetPersonsV2(context.Context, *GetPersonsRequest) (*PersonsList, error)
+	// Same as SearchPerson, but returns persons as an ordered list, empty list if nothing found
+	SearchPersonV2(context.Context, *SearchPersonRequest) (*PersonsList, error)
+	// Same as SearchPersonByName, but returns persons as an ordered list, empty list if nothing found
+	SearchPersonByNameV2(context.Context, *SearchPersonByNameRequest) (*PersonsList, error)
+	// Returns the person, if the id is redirected, returns the person, it was redirected to.
+	// Must be declared before IsPersonExists, the handler, registered later, is matched first,
+	// so /v1/person/exists isn't matched by /v1/person/{ID}
+	GetPerson(context.Context, *GetPersonRequest) (*PersonDetails, error)
 	IsPersonWithIDExists(context.Context, *IsPersonWithIDExistsRequest) (*IsPersonWithIDExistsResponse, error)
+	// Names are matched case insensitive with both person names and with their Cyrillic-Latin transliterations
 	IsPersonExists(context.Context, *IsPersonExistsRequest) (*IsPersonExistsResponse, error)
+	// Returns persons, that are similar to the person, names, transliterations and birthdays proximity are taken into account
+	FindPotentialDuplicates(context.Context, *FindPotentialDuplicatesRequest) (*PotentialDuplicates, error)
 	IsPersonsExists(context.Context, *IsPersonsExistsRequest) (*IsPersonsExistsResponse, error)
 	UpdatePersonFields(context.Context, *UpdatePersonFieldsRequest) (*emptypb.Empty, error)
 	UpdatePerson(context.Context, *UpdatePersonRequest) (*emptypb.Empty, error)
 	CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponce, error)
+	// Validates and creates persons, results are returned in the same order as the persons in the request
+	BatchCreatePersons(context.Context, *BatchCreatePersonsRequest) (*BatchCreatePersonsResponse, error)
+	// Creates persons in best effort mode as they are received,
+	// result is sent for each person, index is the number of the person in the stream
+	BatchCreatePersonsStream(MoviesPersonsServiceV1_BatchCreatePersonsStreamServer) error
+	// Imports persons from the csv or jsonl file, sent in chunks, rows are validated as in CreatePerson
+	// and created in batches, result is sent for each row, index is the line of the row in the file
+	ImportPersons(MoviesPersonsServiceV1_ImportPersonsServer) error
+	// Streams not deleted persons ordered by id in the chosen format, the file is split into chunks.
+	// Over REST the file is returned as is with the content type of the format
+	ExportPersons(*ExportPersonsRequest, MoviesPersonsServiceV1_ExportPersonsServer) error
+	// Streams changes of the persons with the revisions greater than since_revision, then streams new changes as they are made.
+	// Over REST the changes are sent as the server-sent events, the Last-Event-ID header can be used instead of since_revision
+	WatchPersons(*WatchPersonsRequest, MoviesPersonsServiceV1_WatchPersonsServer) error
+	// Applies partial updates in one transaction, missing persons and invalid updates are skipped,
+	// unless all_or_nothing is set, in that case nothing is updated
+	BatchUpdatePersons(context.Context, *BatchUpdatePersonsRequest) (*BatchUpdatePersonsResponse, error)
+	// Marks persons as deleted, deleted persons are removed after the retention period
 	DeletePersons(context.Context, *DeletePersonsRequest) (*DeletePersonsResponce, error)
+	// Restores persons, that were deleted, but not removed yet
+	RestorePersons(context.Context, *RestorePersonsRequest) (*RestorePersonsResponse, error)
+	// Sends person_updated events with the current state of the not deleted persons,
+	// events are marked as the snapshots, before and after of them are the same, used to resync the events consumers
+	ReemitPersonsEvents(context.Context, *ReemitPersonsEventsRequest) (*ReemitPersonsEventsResponse, error)
+	// Copies chosen fields into the target person and removes the source persons,
+	// source persons ids are redirected to the target person
+	MergePersons(context.Context, *MergePersonsRequest) (*MergePersonsResponse, error)
+	// Returns person revisions starting from the latest
+	ListPersonRevisions(context.Context, *ListPersonRevisionsRequest) (*PersonRevisions, error)
+	GetPersonRevisionDiff(context.Context, *GetPersonRevisionDiffRequest) (*PersonRevisionDiff, error)
+	// Sets person fields to the values from the revision, rollback is stored as a new revision
+	RollbackPersonToRevision(context.Context, *RollbackPersonToRevisionRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMoviesPersonsServiceV1Server()
 }
 
@@ -163,12 +496,27 @@ func (UnimplementedMoviesPersonsServiceV1Server) SearchPerson(context.Context, *
 func (UnimplementedMoviesPersonsServiceV1Server) SearchPersonByName(context.Context, *SearchPersonByNameRequest) (*Persons, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchPersonByName not implemented")
 }
+func (UnimplementedMoviesPersonsServiceV1Server) GetPersonsV2(context.Context, *GetPersonsRequest) (*PersonsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonsV2 not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) SearchPersonV2(context.Context, *SearchPersonRequest) (*PersonsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPersonV2 not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) SearchPersonByNameV2(context.Context, *SearchPersonByNameRequest) (*PersonsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPersonByNameV2 not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) GetPerson(context.Context, *GetPersonRequest) (*PersonDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerson not implemented")
+}
 func (UnimplementedMoviesPersonsServiceV1Server) IsPersonWithIDExists(context.Context, *IsPersonWithIDExistsRequest) (*IsPersonWithIDExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsPersonWithIDExists not implemented")
 }
 func (UnimplementedMoviesPersonsServiceV1Server) IsPersonExists(context.Context, *IsPersonExistsRequest) (*IsPersonExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsPersonExists not implemented")
 }
+func (UnimplementedMoviesPersonsServiceV1Server) FindPotentialDuplicates(context.Context, *FindPotentialDuplicatesRequest) (*PotentialDuplicates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPotentialDuplicates not implemented")
+}
 func (UnimplementedMoviesPersonsServiceV1Server) IsPersonsExists(context.Context, *IsPersonsExistsRequest) (*IsPersonsExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsPersonsExists not implemented")
 }
@@ -181,9 +529,45 @@ func (UnimplementedMoviesPersonsServiceV1Server) UpdatePerson(context.Context, *
 func (UnimplementedMoviesPersonsServiceV1Server) CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponce, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePerson not implemented")
 }
+func (UnimplementedMoviesPersonsServiceV1Server) BatchCreatePersons(context.Context, *BatchCreatePersonsRequest) (*BatchCreatePersonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreatePersons not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) BatchCreatePersonsStream(MoviesPersonsServiceV1_BatchCreatePersonsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchCreatePersonsStream not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) ImportPersons(MoviesPersonsServiceV1_ImportPersonsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportPersons not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) ExportPersons(*ExportPersonsRequest, MoviesPersonsServiceV1_ExportPersonsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPersons not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) WatchPersons(*WatchPersonsRequest, MoviesPersonsServiceV1_WatchPersonsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPersons not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) BatchUpdatePersons(context.Context, *BatchUpdatePersonsRequest) (*BatchUpdatePersonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdatePersons not implemented")
+}
 func (UnimplementedMoviesPersonsServiceV1Server) DeletePersons(context.Context, *DeletePersonsRequest) (*DeletePersonsResponce, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePersons not implemented")
 }
+func (UnimplementedMoviesPersonsServiceV1Server) RestorePersons(context.Context, *RestorePersonsRequest) (*RestorePersonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePersons not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) ReemitPersonsEvents(context.Context, *ReemitPersonsEventsRequest) (*ReemitPersonsEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReemitPersonsEvents not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) MergePersons(context.Context, *MergePersonsRequest) (*MergePersonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePersons not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) ListPersonRevisions(context.Context, *ListPersonRevisionsRequest) (*PersonRevisions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonRevisions not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) GetPersonRevisionDiff(context.Context, *GetPersonRevisionDiffRequest) (*PersonRevisionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonRevisionDiff not implemented")
+}
+func (UnimplementedMoviesPersonsServiceV1Server) RollbackPersonToRevision(context.Context, *RollbackPersonToRevisionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPersonToRevision not implemented")
+}
 func (UnimplementedMoviesPersonsServiceV1Server) mustEmbedUnimplementedMoviesPersonsServiceV1Server() {
 }
 
@@ -252,6 +636,78 @@ func _MoviesPersonsServiceV1_SearchPersonByName_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MoviesPersonsServiceV1_GetPersonsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).GetPersonsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/GetPersonsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).GetPersonsV2(ctx, req.(*GetPersonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_SearchPersonV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).SearchPersonV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/SearchPersonV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).SearchPersonV2(ctx, req.(*SearchPersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_SearchPersonByNameV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPersonByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).SearchPersonByNameV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/SearchPersonByNameV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).SearchPersonByNameV2(ctx, req.(*SearchPersonByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_GetPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).GetPerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/GetPerson",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).GetPerson(ctx, req.(*GetPersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MoviesPersonsServiceV1_IsPersonWithIDExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsPersonWithIDExistsRequest)
 	if err := dec(in); err != nil {
@@ -288,6 +744,24 @@ func _MoviesPersonsServiceV1_IsPersonExists_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MoviesPersonsServiceV1_FindPotentialDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPotentialDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).FindPotentialDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/FindPotentialDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).FindPotentialDuplicates(ctx, req.(*FindPotentialDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MoviesPersonsServiceV1_IsPersonsExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsPersonsExistsRequest)
 	if err := dec(in); err != nil {
@@ -360,6 +834,136 @@ func _MoviesPersonsServiceV1_CreatePerson_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MoviesPersonsServiceV1_BatchCreatePersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreatePersonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).BatchCreatePersons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/BatchCreatePersons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).BatchCreatePersons(ctx, req.(*BatchCreatePersonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_BatchCreatePersonsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MoviesPersonsServiceV1Server).BatchCreatePersonsStream(&moviesPersonsServiceV1BatchCreatePersonsStreamServer{stream})
+}
+
+type MoviesPersonsServiceV1_BatchCreatePersonsStreamServer interface {
+	Send(*BatchCreatePersonResult) error
+	Recv() (*CreatePersonRequest, error)
+	grpc.ServerStream
+}
+
+type moviesPersonsServiceV1BatchCreatePersonsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *moviesPersonsServiceV1BatchCreatePersonsStreamServer) Send(m *BatchCreatePersonResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *moviesPersonsServiceV1BatchCreatePersonsStreamServer) Recv() (*CreatePersonRequest, error) {
+	m := new(CreatePersonRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MoviesPersonsServiceV1_ImportPersons_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MoviesPersonsServiceV1Server).ImportPersons(&moviesPersonsServiceV1ImportPersonsServer{stream})
+}
+
+type MoviesPersonsServiceV1_ImportPersonsServer interface {
+	Send(*BatchCreatePersonResult) error
+	Recv() (*ImportPersonsRequest, error)
+	grpc.ServerStream
+}
+
+type moviesPersonsServiceV1ImportPersonsServer struct {
+	grpc.ServerStream
+}
+
+func (x *moviesPersonsServiceV1ImportPersonsServer) Send(m *BatchCreatePersonResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *moviesPersonsServiceV1ImportPersonsServer) Recv() (*ImportPersonsRequest, error) {
+	m := new(ImportPersonsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MoviesPersonsServiceV1_ExportPersons_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPersonsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MoviesPersonsServiceV1Server).ExportPersons(m, &moviesPersonsServiceV1ExportPersonsServer{stream})
+}
+
+type MoviesPersonsServiceV1_ExportPersonsServer interface {
+	Send(*ExportPersonsChunk) error
+	grpc.ServerStream
+}
+
+type moviesPersonsServiceV1ExportPersonsServer struct {
+	grpc.ServerStream
+}
+
+func (x *moviesPersonsServiceV1ExportPersonsServer) Send(m *ExportPersonsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MoviesPersonsServiceV1_WatchPersons_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPersonsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MoviesPersonsServiceV1Server).WatchPersons(m, &moviesPersonsServiceV1WatchPersonsServer{stream})
+}
+
+type MoviesPersonsServiceV1_WatchPersonsServer interface {
+	Send(*PersonChange) error
+	grpc.ServerStream
+}
+
+type moviesPersonsServiceV1WatchPersonsServer struct {
+	grpc.ServerStream
+}
+
+func (x *moviesPersonsServiceV1WatchPersonsServer) Send(m *PersonChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MoviesPersonsServiceV1_BatchUpdatePersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdatePersonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).BatchUpdatePersons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/BatchUpdatePersons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).BatchUpdatePersons(ctx, req.(*BatchUpdatePersonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MoviesPersonsServiceV1_DeletePersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeletePersonsRequest)
 	if err := dec(in); err != nil {
@@ -378,6 +982,114 @@ func _MoviesPersonsServiceV1_DeletePersons_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MoviesPersonsServiceV1_RestorePersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePersonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).RestorePersons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/RestorePersons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).RestorePersons(ctx, req.(*RestorePersonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_ReemitPersonsEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReemitPersonsEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).ReemitPersonsEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/ReemitPersonsEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).ReemitPersonsEvents(ctx, req.(*ReemitPersonsEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_MergePersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePersonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).MergePersons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/MergePersons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).MergePersons(ctx, req.(*MergePersonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_ListPersonRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).ListPersonRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/ListPersonRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).ListPersonRevisions(ctx, req.(*ListPersonRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_GetPersonRevisionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonRevisionDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).GetPersonRevisionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/GetPersonRevisionDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).GetPersonRevisionDiff(ctx, req.(*GetPersonRevisionDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoviesPersonsServiceV1_RollbackPersonToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPersonToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoviesPersonsServiceV1Server).RollbackPersonToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin_movies_persons_service.moviesPersonsServiceV1/RollbackPersonToRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoviesPersonsServiceV1Server).RollbackPersonToRevision(ctx, req.(*RollbackPersonToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MoviesPersonsServiceV1_ServiceDesc is the grpc.ServiceDesc for MoviesPersonsServiceV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,6 +1109,22 @@ var MoviesPersonsServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchPersonByName",
 			Handler:    _MoviesPersonsServiceV1_SearchPersonByName_Handler,
 		},
+		{
+			MethodName: "GetPersonsV2",
+			Handler:    _MoviesPersonsServiceV1_GetPersonsV2_Handler,
+		},
+		{
+			MethodName: "SearchPersonV2",
+			Handler:    _MoviesPersonsServiceV1_SearchPersonV2_Handler,
+		},
+		{
+			MethodName: "SearchPersonByNameV2",
+			Handler:    _MoviesPersonsServiceV1_SearchPersonByNameV2_Handler,
+		},
+		{
+			MethodName: "GetPerson",
+			Handler:    _MoviesPersonsServiceV1_GetPerson_Handler,
+		},
 		{
 			MethodName: "IsPersonWithIDExists",
 			Handler:    _MoviesPersonsServiceV1_IsPersonWithIDExists_Handler,
@@ -405,6 +1133,10 @@ var MoviesPersonsServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsPersonExists",
 			Handler:    _MoviesPersonsServiceV1_IsPersonExists_Handler,
 		},
+		{
+			MethodName: "FindPotentialDuplicates",
+			Handler:    _MoviesPersonsServiceV1_FindPotentialDuplicates_Handler,
+		},
 		{
 			MethodName: "IsPersonsExists",
 			Handler:    _MoviesPersonsServiceV1_IsPersonsExists_Handler,
@@ -421,11 +1153,66 @@ var MoviesPersonsServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePerson",
 			Handler:    _MoviesPersonsServiceV1_CreatePerson_Handler,
 		},
+		{
+			MethodName: "BatchCreatePersons",
+			Handler:    _MoviesPersonsServiceV1_BatchCreatePersons_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePersons",
+			Handler:    _MoviesPersonsServiceV1_BatchUpdatePersons_Handler,
+		},
 		{
 			MethodName: "DeletePersons",
 			Handler:    _MoviesPersonsServiceV1_DeletePersons_Handler,
 		},
+		{
+			MethodName: "RestorePersons",
+			Handler:    _MoviesPersonsServiceV1_RestorePersons_Handler,
+		},
+		{
+			MethodName: "ReemitPersonsEvents",
+			Handler:    _MoviesPersonsServiceV1_ReemitPersonsEvents_Handler,
+		},
+		{
+			MethodName: "MergePersons",
+			Handler:    _MoviesPersonsServiceV1_MergePersons_Handler,
+		},
+		{
+			MethodName: "ListPersonRevisions",
+			Handler:    _MoviesPersonsServiceV1_ListPersonRevisions_Handler,
+		},
+		{
+			MethodName: "GetPersonRevisionDiff",
+			Handler:    _MoviesPersonsServiceV1_GetPersonRevisionDiff_Handler,
+		},
+		{
+			MethodName: "RollbackPersonToRevision",
+			Handler:    _MoviesPersonsServiceV1_RollbackPersonToRevision_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchCreatePersonsStream",
+			Handler:       _MoviesPersonsServiceV1_BatchCreatePersonsStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImportPersons",
+			Handler:       _MoviesPersonsServiceV1_ImportPersons_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportPersons",
+			Handler:       _MoviesPersonsServiceV1_ExportPersons_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPersons",
+			Handler:       _MoviesPersonsServiceV1_WatchPersons_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin_movies_persons_service_v1.proto",
 }
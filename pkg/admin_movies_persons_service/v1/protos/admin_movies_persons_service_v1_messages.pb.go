@@ -9,6 +9,7 @@ package protos
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,6 +22,274 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SearchPersonByNameRequest_SearchMode int32
+
+const (
+	// persons, whose names start with the name
+	SearchPersonByNameRequest_PREFIX SearchPersonByNameRequest_SearchMode = 0
+	// persons, whose names contain words similar to the name, typos are tolerated,
+	// persons are ordered by the similarity score, order_by must be empty or relevance
+	SearchPersonByNameRequest_FUZZY SearchPersonByNameRequest_SearchMode = 1
+)
+
+// Enum value maps for SearchPersonByNameRequest_SearchMode.
+var (
+	SearchPersonByNameRequest_SearchMode_name = map[int32]string{
+		0: "PREFIX",
+		1: "FUZZY",
+	}
+	SearchPersonByNameRequest_SearchMode_value = map[string]int32{
+		"PREFIX": 0,
+		"FUZZY":  1,
+	}
+)
+
+func (x SearchPersonByNameRequest_SearchMode) Enum() *SearchPersonByNameRequest_SearchMode {
+	p := new(SearchPersonByNameRequest_SearchMode)
+	*p = x
+	return p
+}
+
+func (x SearchPersonByNameRequest_SearchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchPersonByNameRequest_SearchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_movies_persons_service_v1_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchPersonByNameRequest_SearchMode) Type() protoreflect.EnumType {
+	return &file_admin_movies_persons_service_v1_messages_proto_enumTypes[0]
+}
+
+func (x SearchPersonByNameRequest_SearchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchPersonByNameRequest_SearchMode.Descriptor instead.
+func (SearchPersonByNameRequest_SearchMode) EnumDescriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type BatchCreatePersonResult_Status int32
+
+const (
+	BatchCreatePersonResult_CREATED BatchCreatePersonResult_Status = 0
+	// person already exists or duplicates another person in the batch
+	BatchCreatePersonResult_ALREADY_EXISTS       BatchCreatePersonResult_Status = 1
+	BatchCreatePersonResult_POTENTIAL_DUPLICATES BatchCreatePersonResult_Status = 2
+	BatchCreatePersonResult_INVALID_ARGUMENT     BatchCreatePersonResult_Status = 3
+	BatchCreatePersonResult_FAILED               BatchCreatePersonResult_Status = 4
+	// person can be created, but isn't, because another person in the all or nothing batch can't be created
+	BatchCreatePersonResult_ABORTED BatchCreatePersonResult_Status = 5
+	// person can be created, set instead of CREATED in the dry run imports
+	BatchCreatePersonResult_VALID BatchCreatePersonResult_Status = 6
+)
+
+// Enum value maps for BatchCreatePersonResult_Status.
+var (
+	BatchCreatePersonResult_Status_name = map[int32]string{
+		0: "CREATED",
+		1: "ALREADY_EXISTS",
+		2: "POTENTIAL_DUPLICATES",
+		3: "INVALID_ARGUMENT",
+		4: "FAILED",
+		5: "ABORTED",
+		6: "VALID",
+	}
+	BatchCreatePersonResult_Status_value = map[string]int32{
+		"CREATED":              0,
+		"ALREADY_EXISTS":       1,
+		"POTENTIAL_DUPLICATES": 2,
+		"INVALID_ARGUMENT":     3,
+		"FAILED":               4,
+		"ABORTED":              5,
+		"VALID":                6,
+	}
+)
+
+func (x BatchCreatePersonResult_Status) Enum() *BatchCreatePersonResult_Status {
+	p := new(BatchCreatePersonResult_Status)
+	*p = x
+	return p
+}
+
+func (x BatchCreatePersonResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchCreatePersonResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_movies_persons_service_v1_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchCreatePersonResult_Status) Type() protoreflect.EnumType {
+	return &file_admin_movies_persons_service_v1_messages_proto_enumTypes[1]
+}
+
+func (x BatchCreatePersonResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchCreatePersonResult_Status.Descriptor instead.
+func (BatchCreatePersonResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{38, 0}
+}
+
+type ImportPersonsRequest_Format int32
+
+const (
+	// comma separated values with the header, columns: fullname_ru, fullname_en, birthday, sex, photo,
+	// unknown columns are ignored, birthday must be in format YYYY-MM-DD,
+	// photo is the http(s) url or the path relative to the service import photos directory
+	ImportPersonsRequest_CSV ImportPersonsRequest_Format = 0
+	// one json object per line with the same fields as the csv columns
+	ImportPersonsRequest_JSONL ImportPersonsRequest_Format = 1
+)
+
+// Enum value maps for ImportPersonsRequest_Format.
+var (
+	ImportPersonsRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "JSONL",
+	}
+	ImportPersonsRequest_Format_value = map[string]int32{
+		"CSV":   0,
+		"JSONL": 1,
+	}
+)
+
+func (x ImportPersonsRequest_Format) Enum() *ImportPersonsRequest_Format {
+	p := new(ImportPersonsRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ImportPersonsRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportPersonsRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_movies_persons_service_v1_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportPersonsRequest_Format) Type() protoreflect.EnumType {
+	return &file_admin_movies_persons_service_v1_messages_proto_enumTypes[2]
+}
+
+func (x ImportPersonsRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportPersonsRequest_Format.Descriptor instead.
+func (ImportPersonsRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{40, 0}
+}
+
+type ExportPersonsRequest_Format int32
+
+const (
+	// comma separated values with the header, can be imported with ImportPersons
+	ExportPersonsRequest_CSV ExportPersonsRequest_Format = 0
+	// one json object per line, can be imported with ImportPersons
+	ExportPersonsRequest_JSONL   ExportPersonsRequest_Format = 1
+	ExportPersonsRequest_PARQUET ExportPersonsRequest_Format = 2
+)
+
+// Enum value maps for ExportPersonsRequest_Format.
+var (
+	ExportPersonsRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "JSONL",
+		2: "PARQUET",
+	}
+	ExportPersonsRequest_Format_value = map[string]int32{
+		"CSV":     0,
+		"JSONL":   1,
+		"PARQUET": 2,
+	}
+)
+
+func (x ExportPersonsRequest_Format) Enum() *ExportPersonsRequest_Format {
+	p := new(ExportPersonsRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportPersonsRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportPersonsRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_movies_persons_service_v1_messages_proto_enumTypes[3].Descriptor()
+}
+
+func (ExportPersonsRequest_Format) Type() protoreflect.EnumType {
+	return &file_admin_movies_persons_service_v1_messages_proto_enumTypes[3]
+}
+
+func (x ExportPersonsRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportPersonsRequest_Format.Descriptor instead.
+func (ExportPersonsRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type PersonChange_Type int32
+
+const (
+	PersonChange_CREATED PersonChange_Type = 0
+	// person is updated, rolled back or other persons are merged into it
+	PersonChange_UPDATED PersonChange_Type = 1
+	// person is deleted or merged into another person
+	PersonChange_DELETED  PersonChange_Type = 2
+	PersonChange_RESTORED PersonChange_Type = 3
+)
+
+// Enum value maps for PersonChange_Type.
+var (
+	PersonChange_Type_name = map[int32]string{
+		0: "CREATED",
+		1: "UPDATED",
+		2: "DELETED",
+		3: "RESTORED",
+	}
+	PersonChange_Type_value = map[string]int32{
+		"CREATED":  0,
+		"UPDATED":  1,
+		"DELETED":  2,
+		"RESTORED": 3,
+	}
+)
+
+func (x PersonChange_Type) Enum() *PersonChange_Type {
+	p := new(PersonChange_Type)
+	*p = x
+	return p
+}
+
+func (x PersonChange_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PersonChange_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_movies_persons_service_v1_messages_proto_enumTypes[4].Descriptor()
+}
+
+func (PersonChange_Type) Type() protoreflect.EnumType {
+	return &file_admin_movies_persons_service_v1_messages_proto_enumTypes[4]
+}
+
+func (x PersonChange_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PersonChange_Type.Descriptor instead.
+func (PersonChange_Type) EnumDescriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{44, 0}
+}
+
 type SearchPersonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -33,8 +302,13 @@ type SearchPersonRequest struct {
 	Photo      []byte                 `protobuf:"bytes,5,opt,name=photo,proto3,oneof" json:"photo,omitempty"`
 	// must be in range 10-100
 	Limit int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
-	// must be > 0
+	// must be > 0, ignored if page_token specified
 	Page int32 `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	// next_page_token from the previous page, if specified, keyset pagination is used
+	PageToken string `protobuf:"bytes,8,opt,name=pageToken,json=page_token,proto3" json:"pageToken,omitempty"`
+	// field and optional direction, separated by space, for example "birthday desc",
+	// supported fields: id, fullname_ru, fullname_en, birthday, created_at, updated_at, default id, nulls are placed last
+	OrderBy string `protobuf:"bytes,9,opt,name=orderBy,json=order_by,proto3" json:"orderBy,omitempty"`
 }
 
 func (x *SearchPersonRequest) Reset() {
@@ -118,6 +392,20 @@ func (x *SearchPersonRequest) GetPage() int32 {
 	return 0
 }
 
+func (x *SearchPersonRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchPersonRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type SearchPersonByNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -126,8 +414,15 @@ type SearchPersonByNameRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=Name,json=name,proto3" json:"Name,omitempty"`
 	// must be in range 10-100
 	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// must be > 0
+	// must be > 0, ignored if page_token specified
 	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// next_page_token from the previous page, if specified, keyset pagination is used
+	PageToken string `protobuf:"bytes,4,opt,name=pageToken,json=page_token,proto3" json:"pageToken,omitempty"`
+	// field and optional direction, separated by space, for example "birthday desc",
+	// supported fields: id, fullname_ru, fullname_en, birthday, created_at, updated_at, default id, nulls are placed last,
+	// relevance is also supported, it's used without direction, most relevant persons go first
+	OrderBy string                               `protobuf:"bytes,5,opt,name=orderBy,json=order_by,proto3" json:"orderBy,omitempty"`
+	Mode    SearchPersonByNameRequest_SearchMode `protobuf:"varint,6,opt,name=mode,proto3,enum=admin_movies_persons_service.SearchPersonByNameRequest_SearchMode" json:"mode,omitempty"`
 }
 
 func (x *SearchPersonByNameRequest) Reset() {
@@ -183,6 +478,27 @@ func (x *SearchPersonByNameRequest) GetPage() int32 {
 	return 0
 }
 
+func (x *SearchPersonByNameRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchPersonByNameRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SearchPersonByNameRequest) GetMode() SearchPersonByNameRequest_SearchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SearchPersonByNameRequest_PREFIX
+}
+
 type DeletePersonsResponce struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -239,8 +555,18 @@ type GetPersonsRequest struct {
 	PersonsIDs string `protobuf:"bytes,1,opt,name=PersonsIDs,json=persons_ids,proto3" json:"PersonsIDs,omitempty"`
 	// must be in range 10-100
 	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// must be > 0
+	// must be > 0, ignored if page_token specified
 	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// next_page_token from the previous page, if specified, keyset pagination is used
+	PageToken string `protobuf:"bytes,4,opt,name=pageToken,json=page_token,proto3" json:"pageToken,omitempty"`
+	// field and optional direction, separated by space, for example "birthday desc",
+	// supported fields: id, fullname_ru, fullname_en, birthday, created_at, updated_at, default id, nulls are placed last
+	OrderBy string `protobuf:"bytes,5,opt,name=orderBy,json=order_by,proto3" json:"orderBy,omitempty"`
+	// time ranges of the persons creation and last change, after is inclusive, before is exclusive
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAfter,json=created_after,proto3" json:"createdAfter,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdBefore,json=created_before,proto3" json:"createdBefore,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAfter,json=updated_after,proto3" json:"updatedAfter,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updatedBefore,json=updated_before,proto3" json:"updatedBefore,omitempty"`
 }
 
 func (x *GetPersonsRequest) Reset() {
@@ -296,6 +622,48 @@ func (x *GetPersonsRequest) GetPage() int32 {
 	return 0
 }
 
+func (x *GetPersonsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetPersonsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetPersonsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *GetPersonsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *GetPersonsRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *GetPersonsRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
 type CreatePersonResponce struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -396,8 +764,11 @@ type IsPersonsExistsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// true if all persons exist or redirected to the existing persons
 	PersonsExists bool    `protobuf:"varint,1,opt,name=PersonsExists,json=persons_exists,proto3" json:"PersonsExists,omitempty"`
 	NotExistIDs   []int32 `protobuf:"varint,2,rep,packed,name=NotExistIDs,json=not_exist_ids,proto3" json:"NotExistIDs,omitempty"`
+	// ids of the merged or renumbered persons to the ids of the persons, they were redirected to
+	RedirectedIDs map[int32]int32 `protobuf:"bytes,3,rep,name=RedirectedIDs,json=redirected_ids,proto3" json:"RedirectedIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *IsPersonsExistsResponse) Reset() {
@@ -446,6 +817,13 @@ func (x *IsPersonsExistsResponse) GetNotExistIDs() []int32 {
 	return nil
 }
 
+func (x *IsPersonsExistsResponse) GetRedirectedIDs() map[int32]int32 {
+	if x != nil {
+		return x.RedirectedIDs
+	}
+	return nil
+}
+
 type UpdatePersonFieldsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -458,6 +836,12 @@ type UpdatePersonFieldsRequest struct {
 	Birthday   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
 	Sex        *string                `protobuf:"bytes,5,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
 	Photo      []byte                 `protobuf:"bytes,6,opt,name=photo,proto3,oneof" json:"photo,omitempty"`
+	// fields to update: fullname_ru, fullname_en, birthday, sex, photo.
+	// Fields from the mask, that aren't specified in the request, are set to null, fullname_ru can't be null.
+	// If mask is empty, only specified fields with the non default values are updated
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=updateMask,json=update_mask,proto3" json:"updateMask,omitempty"`
+	// if specified, person is updated only if its version is equal to the expected, If-Match header can be used instead
+	ExpectedVersion *int32 `protobuf:"varint,8,opt,name=expectedVersion,json=expected_version,proto3,oneof" json:"expectedVersion,omitempty"`
 }
 
 func (x *UpdatePersonFieldsRequest) Reset() {
@@ -534,6 +918,20 @@ func (x *UpdatePersonFieldsRequest) GetPhoto() []byte {
 	return nil
 }
 
+func (x *UpdatePersonFieldsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePersonFieldsRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdatePersonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -546,6 +944,8 @@ type UpdatePersonRequest struct {
 	Birthday   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
 	Sex        string                 `protobuf:"bytes,5,opt,name=sex,proto3" json:"sex,omitempty"`
 	Photo      []byte                 `protobuf:"bytes,6,opt,name=photo,proto3" json:"photo,omitempty"`
+	// if specified, person is updated only if its version is equal to the expected, If-Match header can be used instead
+	ExpectedVersion *int32 `protobuf:"varint,7,opt,name=expectedVersion,json=expected_version,proto3,oneof" json:"expectedVersion,omitempty"`
 }
 
 func (x *UpdatePersonRequest) Reset() {
@@ -622,6 +1022,13 @@ func (x *UpdatePersonRequest) GetPhoto() []byte {
 	return nil
 }
 
+func (x *UpdatePersonRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type CreatePersonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -632,6 +1039,8 @@ type CreatePersonRequest struct {
 	Birthday   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
 	Sex        *string                `protobuf:"bytes,4,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
 	Photo      []byte                 `protobuf:"bytes,5,opt,name=photo,proto3,oneof" json:"photo,omitempty"`
+	// if true, person is created even if there are potential duplicates, exact duplicates are still rejected
+	IgnorePotentialDuplicates bool `protobuf:"varint,6,opt,name=ignorePotentialDuplicates,json=ignore_potential_duplicates,proto3" json:"ignorePotentialDuplicates,omitempty"`
 }
 
 func (x *CreatePersonRequest) Reset() {
@@ -701,6 +1110,13 @@ func (x *CreatePersonRequest) GetPhoto() []byte {
 	return nil
 }
 
+func (x *CreatePersonRequest) GetIgnorePotentialDuplicates() bool {
+	if x != nil {
+		return x.IgnorePotentialDuplicates
+	}
+	return false
+}
+
 type DeletePersonsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -708,6 +1124,9 @@ type DeletePersonsRequest struct {
 
 	// use ',' as separator
 	PersonsIDs string `protobuf:"bytes,1,opt,name=PersonsIDs,json=persons_ids,proto3" json:"PersonsIDs,omitempty"`
+	// if specified, must contain the expected version for each of the persons_ids in the same order,
+	// persons are deleted only if all versions are equal to the expected
+	ExpectedVersions []int32 `protobuf:"varint,2,rep,packed,name=expectedVersions,json=expected_versions,proto3" json:"expectedVersions,omitempty"`
 }
 
 func (x *DeletePersonsRequest) Reset() {
@@ -749,16 +1168,24 @@ func (x *DeletePersonsRequest) GetPersonsIDs() string {
 	return ""
 }
 
-type IsPersonWithIDExistsResponse struct {
+func (x *DeletePersonsRequest) GetExpectedVersions() []int32 {
+	if x != nil {
+		return x.ExpectedVersions
+	}
+	return nil
+}
+
+type RestorePersonsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PersonExists bool `protobuf:"varint,1,opt,name=PersonExists,json=person_exists,proto3" json:"PersonExists,omitempty"`
+	// use ',' as separator
+	PersonsIDs string `protobuf:"bytes,1,opt,name=PersonsIDs,json=persons_ids,proto3" json:"PersonsIDs,omitempty"`
 }
 
-func (x *IsPersonWithIDExistsResponse) Reset() {
-	*x = IsPersonWithIDExistsResponse{}
+func (x *RestorePersonsRequest) Reset() {
+	*x = RestorePersonsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,13 +1193,13 @@ func (x *IsPersonWithIDExistsResponse) Reset() {
 	}
 }
 
-func (x *IsPersonWithIDExistsResponse) String() string {
+func (x *RestorePersonsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsPersonWithIDExistsResponse) ProtoMessage() {}
+func (*RestorePersonsRequest) ProtoMessage() {}
 
-func (x *IsPersonWithIDExistsResponse) ProtoReflect() protoreflect.Message {
+func (x *RestorePersonsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,28 +1211,28 @@ func (x *IsPersonWithIDExistsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsPersonWithIDExistsResponse.ProtoReflect.Descriptor instead.
-func (*IsPersonWithIDExistsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestorePersonsRequest.ProtoReflect.Descriptor instead.
+func (*RestorePersonsRequest) Descriptor() ([]byte, []int) {
 	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *IsPersonWithIDExistsResponse) GetPersonExists() bool {
+func (x *RestorePersonsRequest) GetPersonsIDs() string {
 	if x != nil {
-		return x.PersonExists
+		return x.PersonsIDs
 	}
-	return false
+	return ""
 }
 
-type IsPersonWithIDExistsRequest struct {
+type RestorePersonsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PersonID int32 `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+	RestoredPersonsIDs []int32 `protobuf:"varint,1,rep,packed,name=RestoredPersonsIDs,json=restored_persons_ids,proto3" json:"RestoredPersonsIDs,omitempty"`
 }
 
-func (x *IsPersonWithIDExistsRequest) Reset() {
-	*x = IsPersonWithIDExistsRequest{}
+func (x *RestorePersonsResponse) Reset() {
+	*x = RestorePersonsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -813,13 +1240,13 @@ func (x *IsPersonWithIDExistsRequest) Reset() {
 	}
 }
 
-func (x *IsPersonWithIDExistsRequest) String() string {
+func (x *RestorePersonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsPersonWithIDExistsRequest) ProtoMessage() {}
+func (*RestorePersonsResponse) ProtoMessage() {}
 
-func (x *IsPersonWithIDExistsRequest) ProtoReflect() protoreflect.Message {
+func (x *RestorePersonsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,29 +1258,29 @@ func (x *IsPersonWithIDExistsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsPersonWithIDExistsRequest.ProtoReflect.Descriptor instead.
-func (*IsPersonWithIDExistsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestorePersonsResponse.ProtoReflect.Descriptor instead.
+func (*RestorePersonsResponse) Descriptor() ([]byte, []int) {
 	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *IsPersonWithIDExistsRequest) GetPersonID() int32 {
+func (x *RestorePersonsResponse) GetRestoredPersonsIDs() []int32 {
 	if x != nil {
-		return x.PersonID
+		return x.RestoredPersonsIDs
 	}
-	return 0
+	return nil
 }
 
-type IsPersonExistsResponse struct {
+type ReemitPersonsEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PersonExists     bool    `protobuf:"varint,1,opt,name=PersonExists,json=person_exists,proto3" json:"PersonExists,omitempty"`
-	FindedPersonsIDs []int32 `protobuf:"varint,2,rep,packed,name=FindedPersonsIDs,json=finded_persons_ids,proto3" json:"FindedPersonsIDs,omitempty"`
+	// use ',' as separator
+	PersonsIDs string `protobuf:"bytes,1,opt,name=PersonsIDs,json=persons_ids,proto3" json:"PersonsIDs,omitempty"`
 }
 
-func (x *IsPersonExistsResponse) Reset() {
-	*x = IsPersonExistsResponse{}
+func (x *ReemitPersonsEventsRequest) Reset() {
+	*x = ReemitPersonsEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,13 +1288,13 @@ func (x *IsPersonExistsResponse) Reset() {
 	}
 }
 
-func (x *IsPersonExistsResponse) String() string {
+func (x *ReemitPersonsEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsPersonExistsResponse) ProtoMessage() {}
+func (*ReemitPersonsEventsRequest) ProtoMessage() {}
 
-func (x *IsPersonExistsResponse) ProtoReflect() protoreflect.Message {
+func (x *ReemitPersonsEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -879,38 +1306,28 @@ func (x *IsPersonExistsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsPersonExistsResponse.ProtoReflect.Descriptor instead.
-func (*IsPersonExistsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReemitPersonsEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReemitPersonsEventsRequest) Descriptor() ([]byte, []int) {
 	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *IsPersonExistsResponse) GetPersonExists() bool {
-	if x != nil {
-		return x.PersonExists
-	}
-	return false
-}
-
-func (x *IsPersonExistsResponse) GetFindedPersonsIDs() []int32 {
+func (x *ReemitPersonsEventsRequest) GetPersonsIDs() string {
 	if x != nil {
-		return x.FindedPersonsIDs
+		return x.PersonsIDs
 	}
-	return nil
+	return ""
 }
 
-type IsPersonExistsRequest struct {
+type ReemitPersonsEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FullnameRU *string                `protobuf:"bytes,1,opt,name=fullnameRU,json=fullname_ru,proto3,oneof" json:"fullnameRU,omitempty"`
-	FullnameEN *string                `protobuf:"bytes,2,opt,name=fullnameEN,json=fullname_en,proto3,oneof" json:"fullnameEN,omitempty"`
-	Birthday   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
-	Sex        *string                `protobuf:"bytes,4,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+	ReemittedPersonsIDs []int32 `protobuf:"varint,1,rep,packed,name=ReemittedPersonsIDs,json=reemitted_persons_ids,proto3" json:"ReemittedPersonsIDs,omitempty"`
 }
 
-func (x *IsPersonExistsRequest) Reset() {
-	*x = IsPersonExistsRequest{}
+func (x *ReemitPersonsEventsResponse) Reset() {
+	*x = ReemitPersonsEventsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -918,13 +1335,13 @@ func (x *IsPersonExistsRequest) Reset() {
 	}
 }
 
-func (x *IsPersonExistsRequest) String() string {
+func (x *ReemitPersonsEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsPersonExistsRequest) ProtoMessage() {}
+func (*ReemitPersonsEventsResponse) ProtoMessage() {}
 
-func (x *IsPersonExistsRequest) ProtoReflect() protoreflect.Message {
+func (x *ReemitPersonsEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,12 +1353,211 @@ func (x *IsPersonExistsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsPersonExistsRequest.ProtoReflect.Descriptor instead.
-func (*IsPersonExistsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReemitPersonsEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReemitPersonsEventsResponse) Descriptor() ([]byte, []int) {
 	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *IsPersonExistsRequest) GetFullnameRU() string {
+func (x *ReemitPersonsEventsResponse) GetReemittedPersonsIDs() []int32 {
+	if x != nil {
+		return x.ReemittedPersonsIDs
+	}
+	return nil
+}
+
+type IsPersonWithIDExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonExists bool `protobuf:"varint,1,opt,name=PersonExists,json=person_exists,proto3" json:"PersonExists,omitempty"`
+}
+
+func (x *IsPersonWithIDExistsResponse) Reset() {
+	*x = IsPersonWithIDExistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsPersonWithIDExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsPersonWithIDExistsResponse) ProtoMessage() {}
+
+func (x *IsPersonWithIDExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsPersonWithIDExistsResponse.ProtoReflect.Descriptor instead.
+func (*IsPersonWithIDExistsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IsPersonWithIDExistsResponse) GetPersonExists() bool {
+	if x != nil {
+		return x.PersonExists
+	}
+	return false
+}
+
+type IsPersonWithIDExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonID int32 `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+}
+
+func (x *IsPersonWithIDExistsRequest) Reset() {
+	*x = IsPersonWithIDExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsPersonWithIDExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsPersonWithIDExistsRequest) ProtoMessage() {}
+
+func (x *IsPersonWithIDExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsPersonWithIDExistsRequest.ProtoReflect.Descriptor instead.
+func (*IsPersonWithIDExistsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IsPersonWithIDExistsRequest) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+type IsPersonExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonExists     bool    `protobuf:"varint,1,opt,name=PersonExists,json=person_exists,proto3" json:"PersonExists,omitempty"`
+	FindedPersonsIDs []int32 `protobuf:"varint,2,rep,packed,name=FindedPersonsIDs,json=finded_persons_ids,proto3" json:"FindedPersonsIDs,omitempty"`
+}
+
+func (x *IsPersonExistsResponse) Reset() {
+	*x = IsPersonExistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsPersonExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsPersonExistsResponse) ProtoMessage() {}
+
+func (x *IsPersonExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsPersonExistsResponse.ProtoReflect.Descriptor instead.
+func (*IsPersonExistsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IsPersonExistsResponse) GetPersonExists() bool {
+	if x != nil {
+		return x.PersonExists
+	}
+	return false
+}
+
+func (x *IsPersonExistsResponse) GetFindedPersonsIDs() []int32 {
+	if x != nil {
+		return x.FindedPersonsIDs
+	}
+	return nil
+}
+
+type IsPersonExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FullnameRU *string                `protobuf:"bytes,1,opt,name=fullnameRU,json=fullname_ru,proto3,oneof" json:"fullnameRU,omitempty"`
+	FullnameEN *string                `protobuf:"bytes,2,opt,name=fullnameEN,json=fullname_en,proto3,oneof" json:"fullnameEN,omitempty"`
+	Birthday   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
+	Sex        *string                `protobuf:"bytes,4,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+}
+
+func (x *IsPersonExistsRequest) Reset() {
+	*x = IsPersonExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsPersonExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsPersonExistsRequest) ProtoMessage() {}
+
+func (x *IsPersonExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsPersonExistsRequest.ProtoReflect.Descriptor instead.
+func (*IsPersonExistsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IsPersonExistsRequest) GetFullnameRU() string {
 	if x != nil && x.FullnameRU != nil {
 		return *x.FullnameRU
 	}
@@ -979,12 +1595,22 @@ type Person struct {
 	Birthday   string `protobuf:"bytes,3,opt,name=birthday,proto3" json:"birthday,omitempty"`
 	Sex        string `protobuf:"bytes,4,opt,name=sex,proto3" json:"sex,omitempty"`
 	PhotoUrl   string `protobuf:"bytes,5,opt,name=photoUrl,json=photo_url,proto3" json:"photoUrl,omitempty"`
+	ID         int32  `protobuf:"varint,6,opt,name=ID,json=id,proto3" json:"ID,omitempty"`
+	// similarity score in range [0;1], set only by the fuzzy search
+	Score float32 `protobuf:"fixed32,7,opt,name=score,proto3" json:"score,omitempty"`
+	// incremented on every change of the person, also returned in the ETag header of the updates
+	Version   int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,json=created_at,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,json=updated_at,proto3" json:"updatedAt,omitempty"`
+	// ids of the accounts, that created and last changed the person
+	CreatedBy string `protobuf:"bytes,11,opt,name=createdBy,json=created_by,proto3" json:"createdBy,omitempty"`
+	UpdatedBy string `protobuf:"bytes,12,opt,name=updatedBy,json=updated_by,proto3" json:"updatedBy,omitempty"`
 }
 
 func (x *Person) Reset() {
 	*x = Person{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[15]
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -997,7 +1623,7 @@ func (x *Person) String() string {
 func (*Person) ProtoMessage() {}
 
 func (x *Person) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[15]
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1636,7 @@ func (x *Person) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Person.ProtoReflect.Descriptor instead.
 func (*Person) Descriptor() ([]byte, []int) {
-	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{15}
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Person) GetFullnameRU() string {
@@ -1048,327 +1674,3056 @@ func (x *Person) GetPhotoUrl() string {
 	return ""
 }
 
-type Persons struct {
+func (x *Person) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Person) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Person) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Person) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Person) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Person) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Person) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type GetPersonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Persons map[string]*Person `protobuf:"bytes,1,rep,name=persons,proto3" json:"persons,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ID int32 `protobuf:"varint,1,opt,name=ID,json=id,proto3" json:"ID,omitempty"`
 }
 
-func (x *Persons) Reset() {
-	*x = Persons{}
+func (x *GetPersonRequest) Reset() {
+	*x = GetPersonRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[16]
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Persons) String() string {
+func (x *GetPersonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Persons) ProtoMessage() {}
+func (*GetPersonRequest) ProtoMessage() {}
 
-func (x *Persons) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[16]
+func (x *GetPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Persons.ProtoReflect.Descriptor instead.
-func (*Persons) Descriptor() ([]byte, []int) {
-	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *Persons) GetPersons() map[string]*Person {
-	if x != nil {
-		return x.Persons
-	}
-	return nil
-}
-
-type UserErrorMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *UserErrorMessage) Reset() {
-	*x = UserErrorMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *UserErrorMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UserErrorMessage) ProtoMessage() {}
-
-func (x *UserErrorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPersonRequest) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type PersonDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         int32  `protobuf:"varint,1,opt,name=ID,json=id,proto3" json:"ID,omitempty"`
+	FullnameRU string `protobuf:"bytes,2,opt,name=fullnameRU,json=fullname_ru,proto3" json:"fullnameRU,omitempty"`
+	FullnameEN string `protobuf:"bytes,3,opt,name=fullnameEN,json=fullname_en,proto3" json:"fullnameEN,omitempty"`
+	// in format YYYY-MM-DD
+	Birthday string `protobuf:"bytes,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Sex      string `protobuf:"bytes,5,opt,name=sex,proto3" json:"sex,omitempty"`
+	PhotoID  string `protobuf:"bytes,6,opt,name=photoID,json=photo_id,proto3" json:"photoID,omitempty"`
+	PhotoUrl string `protobuf:"bytes,7,opt,name=photoUrl,json=photo_url,proto3" json:"photoUrl,omitempty"`
+	// incremented on every change of the person, also returned in the ETag header
+	Version   int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,json=created_at,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updatedAt,json=updated_at,proto3" json:"updatedAt,omitempty"`
+	// ids of the accounts, that created and last changed the person
+	CreatedBy string `protobuf:"bytes,11,opt,name=createdBy,json=created_by,proto3" json:"createdBy,omitempty"`
+	UpdatedBy string `protobuf:"bytes,12,opt,name=updatedBy,json=updated_by,proto3" json:"updatedBy,omitempty"`
+}
+
+func (x *PersonDetails) Reset() {
+	*x = PersonDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonDetails) ProtoMessage() {}
+
+func (x *PersonDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonDetails.ProtoReflect.Descriptor instead.
+func (*PersonDetails) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PersonDetails) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PersonDetails) GetFullnameRU() string {
+	if x != nil {
+		return x.FullnameRU
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetFullnameEN() string {
+	if x != nil {
+		return x.FullnameEN
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetPhotoID() string {
+	if x != nil {
+		return x.PhotoID
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PersonDetails) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PersonDetails) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PersonDetails) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Persons struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Persons map[string]*Person `protobuf:"bytes,1,rep,name=persons,proto3" json:"persons,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// token for the next page, empty if there are no more persons
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,json=next_page_token,proto3" json:"nextPageToken,omitempty"`
+	// requested ids of the merged or renumbered persons to the ids of the returned persons
+	RedirectedIDs map[int32]int32 `protobuf:"bytes,3,rep,name=redirectedIDs,json=redirected_ids,proto3" json:"redirectedIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Persons) Reset() {
+	*x = Persons{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Persons) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Persons) ProtoMessage() {}
+
+func (x *Persons) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Persons.ProtoReflect.Descriptor instead.
+func (*Persons) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Persons) GetPersons() map[string]*Person {
+	if x != nil {
+		return x.Persons
+	}
+	return nil
+}
+
+func (x *Persons) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *Persons) GetRedirectedIDs() map[int32]int32 {
+	if x != nil {
+		return x.RedirectedIDs
+	}
+	return nil
+}
+
+type PersonsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// persons in the requested order
+	Persons []*Person `protobuf:"bytes,1,rep,name=persons,proto3" json:"persons,omitempty"`
+	// number of persons, that match the request, on all pages
+	TotalCount int64 `protobuf:"varint,2,opt,name=totalCount,json=total_count,proto3" json:"totalCount,omitempty"`
+	HasMore    bool  `protobuf:"varint,3,opt,name=hasMore,json=has_more,proto3" json:"hasMore,omitempty"`
+	// token for the next page, empty if there are no more persons
+	NextPageToken string `protobuf:"bytes,4,opt,name=nextPageToken,json=next_page_token,proto3" json:"nextPageToken,omitempty"`
+	// requested ids of the merged or renumbered persons to the ids of the returned persons
+	RedirectedIDs map[int32]int32 `protobuf:"bytes,5,rep,name=redirectedIDs,json=redirected_ids,proto3" json:"redirectedIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *PersonsList) Reset() {
+	*x = PersonsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonsList) ProtoMessage() {}
+
+func (x *PersonsList) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonsList.ProtoReflect.Descriptor instead.
+func (*PersonsList) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PersonsList) GetPersons() []*Person {
+	if x != nil {
+		return x.Persons
+	}
+	return nil
+}
+
+func (x *PersonsList) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *PersonsList) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *PersonsList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PersonsList) GetRedirectedIDs() map[int32]int32 {
+	if x != nil {
+		return x.RedirectedIDs
+	}
+	return nil
+}
+
+type ListPersonRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonID int32 `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+	// must be in range 10-100
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// must be > 0
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListPersonRevisionsRequest) Reset() {
+	*x = ListPersonRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPersonRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonRevisionsRequest) ProtoMessage() {}
+
+func (x *ListPersonRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPersonRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPersonRevisionsRequest) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+func (x *ListPersonRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPersonRevisionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type PersonRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision int32 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// created, updated, deleted, restored or rolled_back
+	Action     string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	FullnameRU string                 `protobuf:"bytes,3,opt,name=fullnameRU,json=fullname_ru,proto3" json:"fullnameRU,omitempty"`
+	FullnameEN string                 `protobuf:"bytes,4,opt,name=fullnameEN,json=fullname_en,proto3" json:"fullnameEN,omitempty"`
+	Birthday   string                 `protobuf:"bytes,5,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Sex        string                 `protobuf:"bytes,6,opt,name=sex,proto3" json:"sex,omitempty"`
+	PhotoUrl   string                 `protobuf:"bytes,7,opt,name=photoUrl,json=photo_url,proto3" json:"photoUrl,omitempty"`
+	ChangedBy  string                 `protobuf:"bytes,8,opt,name=changedBy,json=changed_by,proto3" json:"changedBy,omitempty"`
+	ChangedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=changedAt,json=changed_at,proto3" json:"changedAt,omitempty"`
+}
+
+func (x *PersonRevision) Reset() {
+	*x = PersonRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRevision) ProtoMessage() {}
+
+func (x *PersonRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRevision.ProtoReflect.Descriptor instead.
+func (*PersonRevision) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PersonRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PersonRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PersonRevision) GetFullnameRU() string {
+	if x != nil {
+		return x.FullnameRU
+	}
+	return ""
+}
+
+func (x *PersonRevision) GetFullnameEN() string {
+	if x != nil {
+		return x.FullnameEN
+	}
+	return ""
+}
+
+func (x *PersonRevision) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *PersonRevision) GetSex() string {
+	if x != nil {
+		return x.Sex
+	}
+	return ""
+}
+
+func (x *PersonRevision) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *PersonRevision) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *PersonRevision) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type PersonRevisions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*PersonRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *PersonRevisions) Reset() {
+	*x = PersonRevisions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonRevisions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRevisions) ProtoMessage() {}
+
+func (x *PersonRevisions) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRevisions.ProtoReflect.Descriptor instead.
+func (*PersonRevisions) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PersonRevisions) GetRevisions() []*PersonRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetPersonRevisionDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonID     int32 `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+	FromRevision int32 `protobuf:"varint,2,opt,name=fromRevision,json=from_revision,proto3" json:"fromRevision,omitempty"`
+	ToRevision   int32 `protobuf:"varint,3,opt,name=toRevision,json=to_revision,proto3" json:"toRevision,omitempty"`
+}
+
+func (x *GetPersonRevisionDiffRequest) Reset() {
+	*x = GetPersonRevisionDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPersonRevisionDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonRevisionDiffRequest) ProtoMessage() {}
+
+func (x *GetPersonRevisionDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonRevisionDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRevisionDiffRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPersonRevisionDiffRequest) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+func (x *GetPersonRevisionDiffRequest) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *GetPersonRevisionDiffRequest) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type PersonFieldDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// json name of the field
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=oldValue,json=old_value,proto3" json:"oldValue,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=newValue,json=new_value,proto3" json:"newValue,omitempty"`
+}
+
+func (x *PersonFieldDiff) Reset() {
+	*x = PersonFieldDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonFieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonFieldDiff) ProtoMessage() {}
+
+func (x *PersonFieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonFieldDiff.ProtoReflect.Descriptor instead.
+func (*PersonFieldDiff) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PersonFieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PersonFieldDiff) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *PersonFieldDiff) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type PersonRevisionDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From    *PersonRevision    `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      *PersonRevision    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Changes []*PersonFieldDiff `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *PersonRevisionDiff) Reset() {
+	*x = PersonRevisionDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonRevisionDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRevisionDiff) ProtoMessage() {}
+
+func (x *PersonRevisionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRevisionDiff.ProtoReflect.Descriptor instead.
+func (*PersonRevisionDiff) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PersonRevisionDiff) GetFrom() *PersonRevision {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PersonRevisionDiff) GetTo() *PersonRevision {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PersonRevisionDiff) GetChanges() []*PersonFieldDiff {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type RollbackPersonToRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonID int32 `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+	Revision int32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RollbackPersonToRevisionRequest) Reset() {
+	*x = RollbackPersonToRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackPersonToRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackPersonToRevisionRequest) ProtoMessage() {}
+
+func (x *RollbackPersonToRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackPersonToRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RollbackPersonToRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RollbackPersonToRevisionRequest) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+func (x *RollbackPersonToRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type FindPotentialDuplicatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// if specified, duplicates of the person with this id are searched and person fields are ignored
+	PersonID   int32                  `protobuf:"varint,1,opt,name=PersonID,json=person_id,proto3" json:"PersonID,omitempty"`
+	FullnameRU *string                `protobuf:"bytes,2,opt,name=fullnameRU,json=fullname_ru,proto3,oneof" json:"fullnameRU,omitempty"`
+	FullnameEN *string                `protobuf:"bytes,3,opt,name=fullnameEN,json=fullname_en,proto3,oneof" json:"fullnameEN,omitempty"`
+	Birthday   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"`
+	Sex        *string                `protobuf:"bytes,5,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+	// min score of the returned duplicates in range [0;1], default 0.6
+	MinScore *float32 `protobuf:"fixed32,6,opt,name=minScore,json=min_score,proto3,oneof" json:"minScore,omitempty"`
+}
+
+func (x *FindPotentialDuplicatesRequest) Reset() {
+	*x = FindPotentialDuplicatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPotentialDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPotentialDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindPotentialDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPotentialDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindPotentialDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FindPotentialDuplicatesRequest) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+func (x *FindPotentialDuplicatesRequest) GetFullnameRU() string {
+	if x != nil && x.FullnameRU != nil {
+		return *x.FullnameRU
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetFullnameEN() string {
+	if x != nil && x.FullnameEN != nil {
+		return *x.FullnameEN
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetBirthday() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Birthday
+	}
+	return nil
+}
+
+func (x *FindPotentialDuplicatesRequest) GetSex() string {
+	if x != nil && x.Sex != nil {
+		return *x.Sex
+	}
+	return ""
+}
+
+func (x *FindPotentialDuplicatesRequest) GetMinScore() float32 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+type PotentialDuplicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Person *Person `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	// in range [0;1], the higher the score, the more likely the person is a duplicate
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	// similarity of the names in range [0;1], names are normalized and transliterated before the comparison
+	NameScore float32 `protobuf:"fixed32,3,opt,name=nameScore,json=name_score,proto3" json:"nameScore,omitempty"`
+	// difference between the birthdays in days, not set if any of the birthdays is unknown
+	BirthdayDiffDays *int32 `protobuf:"varint,4,opt,name=birthdayDiffDays,json=birthday_diff_days,proto3,oneof" json:"birthdayDiffDays,omitempty"`
+}
+
+func (x *PotentialDuplicate) Reset() {
+	*x = PotentialDuplicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PotentialDuplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PotentialDuplicate) ProtoMessage() {}
+
+func (x *PotentialDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PotentialDuplicate.ProtoReflect.Descriptor instead.
+func (*PotentialDuplicate) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PotentialDuplicate) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *PotentialDuplicate) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PotentialDuplicate) GetNameScore() float32 {
+	if x != nil {
+		return x.NameScore
+	}
+	return 0
+}
+
+func (x *PotentialDuplicate) GetBirthdayDiffDays() int32 {
+	if x != nil && x.BirthdayDiffDays != nil {
+		return *x.BirthdayDiffDays
+	}
+	return 0
+}
+
+type PotentialDuplicates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ordered by the score
+	Duplicates []*PotentialDuplicate `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *PotentialDuplicates) Reset() {
+	*x = PotentialDuplicates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PotentialDuplicates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PotentialDuplicates) ProtoMessage() {}
+
+func (x *PotentialDuplicates) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PotentialDuplicates.ProtoReflect.Descriptor instead.
+func (*PotentialDuplicates) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PotentialDuplicates) GetDuplicates() []*PotentialDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+// Ids of the persons, whose values are used, 0 means the target person value
+type MergeFieldResolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FullnameRU int32 `protobuf:"varint,1,opt,name=fullnameRU,json=fullname_ru,proto3" json:"fullnameRU,omitempty"`
+	FullnameEN int32 `protobuf:"varint,2,opt,name=fullnameEN,json=fullname_en,proto3" json:"fullnameEN,omitempty"`
+	Birthday   int32 `protobuf:"varint,3,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Sex        int32 `protobuf:"varint,4,opt,name=sex,proto3" json:"sex,omitempty"`
+	Photo      int32 `protobuf:"varint,5,opt,name=photo,proto3" json:"photo,omitempty"`
+}
+
+func (x *MergeFieldResolution) Reset() {
+	*x = MergeFieldResolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeFieldResolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeFieldResolution) ProtoMessage() {}
+
+func (x *MergeFieldResolution) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeFieldResolution.ProtoReflect.Descriptor instead.
+func (*MergeFieldResolution) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MergeFieldResolution) GetFullnameRU() int32 {
+	if x != nil {
+		return x.FullnameRU
+	}
+	return 0
+}
+
+func (x *MergeFieldResolution) GetFullnameEN() int32 {
+	if x != nil {
+		return x.FullnameEN
+	}
+	return 0
+}
+
+func (x *MergeFieldResolution) GetBirthday() int32 {
+	if x != nil {
+		return x.Birthday
+	}
+	return 0
+}
+
+func (x *MergeFieldResolution) GetSex() int32 {
+	if x != nil {
+		return x.Sex
+	}
+	return 0
+}
+
+func (x *MergeFieldResolution) GetPhoto() int32 {
+	if x != nil {
+		return x.Photo
+	}
+	return 0
+}
+
+type MergePersonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceIDs       []int32               `protobuf:"varint,1,rep,packed,name=sourceIDs,json=source_ids,proto3" json:"sourceIDs,omitempty"`
+	TargetID        int32                 `protobuf:"varint,2,opt,name=targetID,json=target_id,proto3" json:"targetID,omitempty"`
+	FieldResolution *MergeFieldResolution `protobuf:"bytes,3,opt,name=fieldResolution,json=field_resolution,proto3" json:"fieldResolution,omitempty"`
+}
+
+func (x *MergePersonsRequest) Reset() {
+	*x = MergePersonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePersonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePersonsRequest) ProtoMessage() {}
+
+func (x *MergePersonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePersonsRequest.ProtoReflect.Descriptor instead.
+func (*MergePersonsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MergePersonsRequest) GetSourceIDs() []int32 {
+	if x != nil {
+		return x.SourceIDs
+	}
+	return nil
+}
+
+func (x *MergePersonsRequest) GetTargetID() int32 {
+	if x != nil {
+		return x.TargetID
+	}
+	return 0
+}
+
+func (x *MergePersonsRequest) GetFieldResolution() *MergeFieldResolution {
+	if x != nil {
+		return x.FieldResolution
+	}
+	return nil
+}
+
+type MergePersonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetID  int32   `protobuf:"varint,1,opt,name=targetID,json=target_id,proto3" json:"targetID,omitempty"`
+	MergedIDs []int32 `protobuf:"varint,2,rep,packed,name=mergedIDs,json=merged_ids,proto3" json:"mergedIDs,omitempty"`
+}
+
+func (x *MergePersonsResponse) Reset() {
+	*x = MergePersonsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergePersonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePersonsResponse) ProtoMessage() {}
+
+func (x *MergePersonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePersonsResponse.ProtoReflect.Descriptor instead.
+func (*MergePersonsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MergePersonsResponse) GetTargetID() int32 {
+	if x != nil {
+		return x.TargetID
+	}
+	return 0
+}
+
+func (x *MergePersonsResponse) GetMergedIDs() []int32 {
+	if x != nil {
+		return x.MergedIDs
+	}
+	return nil
+}
+
+type BatchCreatePersonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// must contain 1-1000 persons
+	Persons []*CreatePersonRequest `protobuf:"bytes,1,rep,name=persons,proto3" json:"persons,omitempty"`
+	// if true, persons are created only if all of them can be created,
+	// otherwise persons, that can't be created, are skipped
+	AllOrNothing bool `protobuf:"varint,2,opt,name=allOrNothing,json=all_or_nothing,proto3" json:"allOrNothing,omitempty"`
+}
+
+func (x *BatchCreatePersonsRequest) Reset() {
+	*x = BatchCreatePersonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreatePersonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePersonsRequest) ProtoMessage() {}
+
+func (x *BatchCreatePersonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePersonsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreatePersonsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchCreatePersonsRequest) GetPersons() []*CreatePersonRequest {
+	if x != nil {
+		return x.Persons
+	}
+	return nil
+}
+
+func (x *BatchCreatePersonsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchCreatePersonResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index of the person in the request
+	Index  int32                          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Status BatchCreatePersonResult_Status `protobuf:"varint,2,opt,name=status,proto3,enum=admin_movies_persons_service.BatchCreatePersonResult_Status" json:"status,omitempty"`
+	// set when status is CREATED
+	PersonID int32 `protobuf:"varint,3,opt,name=personID,json=person_id,proto3" json:"personID,omitempty"`
+	// set when status is ALREADY_EXISTS
+	ExistingPersonsIDs []int32 `protobuf:"varint,4,rep,packed,name=existingPersonsIDs,json=existing_persons_ids,proto3" json:"existingPersonsIDs,omitempty"`
+	// set when status is POTENTIAL_DUPLICATES, ordered by the score
+	Duplicates []*PotentialDuplicate `protobuf:"bytes,5,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Error      string                `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchCreatePersonResult) Reset() {
+	*x = BatchCreatePersonResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreatePersonResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePersonResult) ProtoMessage() {}
+
+func (x *BatchCreatePersonResult) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePersonResult.ProtoReflect.Descriptor instead.
+func (*BatchCreatePersonResult) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchCreatePersonResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchCreatePersonResult) GetStatus() BatchCreatePersonResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return BatchCreatePersonResult_CREATED
+}
+
+func (x *BatchCreatePersonResult) GetPersonID() int32 {
+	if x != nil {
+		return x.PersonID
+	}
+	return 0
+}
+
+func (x *BatchCreatePersonResult) GetExistingPersonsIDs() []int32 {
+	if x != nil {
+		return x.ExistingPersonsIDs
+	}
+	return nil
+}
+
+func (x *BatchCreatePersonResult) GetDuplicates() []*PotentialDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+func (x *BatchCreatePersonResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchCreatePersonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// in the same order as the persons in the request
+	Results      []*BatchCreatePersonResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedCount int32                      `protobuf:"varint,2,opt,name=createdCount,json=created_count,proto3" json:"createdCount,omitempty"`
+}
+
+func (x *BatchCreatePersonsResponse) Reset() {
+	*x = BatchCreatePersonsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreatePersonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreatePersonsResponse) ProtoMessage() {}
+
+func (x *BatchCreatePersonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreatePersonsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreatePersonsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchCreatePersonsResponse) GetResults() []*BatchCreatePersonResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchCreatePersonsResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+type ImportPersonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// format and options are read from the first message of the stream
+	Format ImportPersonsRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=admin_movies_persons_service.ImportPersonsRequest_Format" json:"format,omitempty"`
+	// if true, rows are validated and checked for duplicates, but persons aren't created
+	DryRun bool `protobuf:"varint,2,opt,name=dryRun,json=dry_run,proto3" json:"dryRun,omitempty"`
+	// if true, persons are created even if there are potential duplicates, exact duplicates are still rejected
+	IgnorePotentialDuplicates bool `protobuf:"varint,3,opt,name=ignorePotentialDuplicates,json=ignore_potential_duplicates,proto3" json:"ignorePotentialDuplicates,omitempty"`
+	// next part of the file, the file can be split at any byte
+	Chunk []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ImportPersonsRequest) Reset() {
+	*x = ImportPersonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportPersonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPersonsRequest) ProtoMessage() {}
+
+func (x *ImportPersonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPersonsRequest.ProtoReflect.Descriptor instead.
+func (*ImportPersonsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportPersonsRequest) GetFormat() ImportPersonsRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ImportPersonsRequest_CSV
+}
+
+func (x *ImportPersonsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportPersonsRequest) GetIgnorePotentialDuplicates() bool {
+	if x != nil {
+		return x.IgnorePotentialDuplicates
+	}
+	return false
+}
+
+func (x *ImportPersonsRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ExportPersonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ExportPersonsRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=admin_movies_persons_service.ExportPersonsRequest_Format" json:"format,omitempty"`
+	// if specified, only persons changed at or after the time are exported
+	UpdatedSince *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updatedSince,json=updated_since,proto3,oneof" json:"updatedSince,omitempty"`
+	// if specified, only persons with or without photo are exported
+	HasPhoto *bool `protobuf:"varint,3,opt,name=hasPhoto,json=has_photo,proto3,oneof" json:"hasPhoto,omitempty"`
+	// if specified, only persons with the sex are exported
+	Sex *string `protobuf:"bytes,4,opt,name=sex,proto3,oneof" json:"sex,omitempty"`
+}
+
+func (x *ExportPersonsRequest) Reset() {
+	*x = ExportPersonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPersonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPersonsRequest) ProtoMessage() {}
+
+func (x *ExportPersonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPersonsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPersonsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExportPersonsRequest) GetFormat() ExportPersonsRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportPersonsRequest_CSV
+}
+
+func (x *ExportPersonsRequest) GetUpdatedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return nil
+}
+
+func (x *ExportPersonsRequest) GetHasPhoto() bool {
+	if x != nil && x.HasPhoto != nil {
+		return *x.HasPhoto
+	}
+	return false
+}
+
+func (x *ExportPersonsRequest) GetSex() string {
+	if x != nil && x.Sex != nil {
+		return *x.Sex
+	}
+	return ""
+}
+
+type ExportPersonsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// next part of the file
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportPersonsChunk) Reset() {
+	*x = ExportPersonsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPersonsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPersonsChunk) ProtoMessage() {}
+
+func (x *ExportPersonsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPersonsChunk.ProtoReflect.Descriptor instead.
+func (*ExportPersonsChunk) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportPersonsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WatchPersonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// revision of the last received change, if not specified, only changes made after the call are streamed.
+	// If changes after the revision are already removed, OUT_OF_RANGE is returned and persons must be reloaded
+	SinceRevision *int64 `protobuf:"varint,1,opt,name=sinceRevision,json=since_revision,proto3,oneof" json:"sinceRevision,omitempty"`
+}
+
+func (x *WatchPersonsRequest) Reset() {
+	*x = WatchPersonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPersonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPersonsRequest) ProtoMessage() {}
+
+func (x *WatchPersonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPersonsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPersonsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WatchPersonsRequest) GetSinceRevision() int64 {
+	if x != nil && x.SinceRevision != nil {
+		return *x.SinceRevision
+	}
+	return 0
+}
+
+type PersonChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// increases in the order of the changes commits, can be used as since_revision to resume watching
+	Revision int64             `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Type     PersonChange_Type `protobuf:"varint,2,opt,name=type,proto3,enum=admin_movies_persons_service.PersonChange_Type" json:"type,omitempty"`
+	// state of the person after the change
+	Person    *Person                `protobuf:"bytes,3,opt,name=person,proto3" json:"person,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changedAt,json=changed_at,proto3" json:"changedAt,omitempty"`
+}
+
+func (x *PersonChange) Reset() {
+	*x = PersonChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonChange) ProtoMessage() {}
+
+func (x *PersonChange) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonChange.ProtoReflect.Descriptor instead.
+func (*PersonChange) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PersonChange) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PersonChange) GetType() PersonChange_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PersonChange_CREATED
+}
+
+func (x *PersonChange) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *PersonChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type BatchUpdatePersonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// partial updates, only specified fields are updated, must contain 1-1000 updates
+	Updates []*UpdatePersonFieldsRequest `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	// if true, persons are updated only if all updates can be applied
+	AllOrNothing bool `protobuf:"varint,2,opt,name=allOrNothing,json=all_or_nothing,proto3" json:"allOrNothing,omitempty"`
+}
+
+func (x *BatchUpdatePersonsRequest) Reset() {
+	*x = BatchUpdatePersonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdatePersonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePersonsRequest) ProtoMessage() {}
+
+func (x *BatchUpdatePersonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePersonsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePersonsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BatchUpdatePersonsRequest) GetUpdates() []*UpdatePersonFieldsRequest {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *BatchUpdatePersonsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type InvalidPersonUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index of the update in the request
+	Index int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ID    int32  `protobuf:"varint,2,opt,name=ID,json=id,proto3" json:"ID,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *InvalidPersonUpdate) Reset() {
+	*x = InvalidPersonUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidPersonUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidPersonUpdate) ProtoMessage() {}
+
+func (x *InvalidPersonUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidPersonUpdate.ProtoReflect.Descriptor instead.
+func (*InvalidPersonUpdate) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InvalidPersonUpdate) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *InvalidPersonUpdate) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *InvalidPersonUpdate) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchUpdatePersonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedIDs     []int32                `protobuf:"varint,1,rep,packed,name=updatedIDs,json=updated_ids,proto3" json:"updatedIDs,omitempty"`
+	NotFoundIDs    []int32                `protobuf:"varint,2,rep,packed,name=notFoundIDs,json=not_found_ids,proto3" json:"notFoundIDs,omitempty"`
+	InvalidUpdates []*InvalidPersonUpdate `protobuf:"bytes,3,rep,name=invalidUpdates,json=invalid_updates,proto3" json:"invalidUpdates,omitempty"`
+}
+
+func (x *BatchUpdatePersonsResponse) Reset() {
+	*x = BatchUpdatePersonsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdatePersonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePersonsResponse) ProtoMessage() {}
+
+func (x *BatchUpdatePersonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePersonsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePersonsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BatchUpdatePersonsResponse) GetUpdatedIDs() []int32 {
+	if x != nil {
+		return x.UpdatedIDs
+	}
+	return nil
+}
+
+func (x *BatchUpdatePersonsResponse) GetNotFoundIDs() []int32 {
+	if x != nil {
+		return x.NotFoundIDs
+	}
+	return nil
+}
+
+func (x *BatchUpdatePersonsResponse) GetInvalidUpdates() []*InvalidPersonUpdate {
+	if x != nil {
+		return x.InvalidUpdates
+	}
+	return nil
+}
+
+type UserErrorMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// set when person rejected as a potential duplicate, ordered by the score
+	Duplicates []*PotentialDuplicate `protobuf:"bytes,2,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *UserErrorMessage) Reset() {
+	*x = UserErrorMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserErrorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserErrorMessage) ProtoMessage() {}
+
+func (x *UserErrorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_movies_persons_service_v1_messages_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserErrorMessage.ProtoReflect.Descriptor instead.
+func (*UserErrorMessage) Descriptor() ([]byte, []int) {
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UserErrorMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UserErrorMessage) GetDuplicates() []*PotentialDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+var File_admin_movies_persons_service_v1_messages_proto protoreflect.FileDescriptor
+
+var file_admin_movies_persons_service_v1_messages_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
+	0x31, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x1c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xf1, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c,
+	0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x04, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c,
+	0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68,
+	0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x65, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x02, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x19, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x12, 0x56, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x23, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10, 0x01, 0x22, 0x46, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x2d, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x13, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73,
+	0x22, 0xa0, 0x03, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x19, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x3f, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x41, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x22, 0x33, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x16, 0x49, 0x73, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x69, 0x64, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x17, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x74,
+	0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x6f, 0x0a, 0x0d, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x48, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa4, 0x03,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0a, 0x66,
+	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68,
+	0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x03, 0x73, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x04, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x12, 0x2e, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65,
+	0x52, 0x55, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45,
+	0x4e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x73, 0x65, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x02, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0a,
+	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x1f, 0x0a,
+	0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x12, 0x36,
+	0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x69,
+	0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x2e,
+	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xb9, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75,
+	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x24, 0x0a, 0x0a, 0x66,
+	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x3b, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x01, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x73,
+	0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x03, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x88, 0x01, 0x01,
+	0x12, 0x3e, 0x0a, 0x19, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x1b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x73, 0x65, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0x64,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x38, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x4a,
+	0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x3d, 0x0a, 0x1a, 0x52, 0x65,
+	0x65, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x52, 0x65, 0x65,
+	0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x65, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x15, 0x72, 0x65, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x1c,
+	0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0c,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x22, 0x3a, 0x0a, 0x1b, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x49, 0x44, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x08, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x6b, 0x0a,
+	0x16, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x10,
+	0x46, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x12, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x15, 0x49,
+	0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65,
+	0x52, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75,
+	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x3b, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02,
+	0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x73, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x78, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d,
+	0x65, 0x52, 0x55, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65,
+	0x45, 0x4e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x73, 0x65, 0x78, 0x22, 0x89, 0x03, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x72, 0x75, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45,
+	0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x65, 0x78, 0x12, 0x1b, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x95, 0x03, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75, 0x6c,
+	0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75,
+	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
+	0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x5f, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x12, 0x1d, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22,
+	0x84, 0x03, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x5f, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49,
+	0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2e,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x73, 0x1a, 0x60, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x02, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f,
+	0x72, 0x65, 0x12, 0x26, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x63, 0x0a, 0x0d, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x1a,
+	0x40, 0x0a, 0x12, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x44, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x63, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x08, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xab, 0x02, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x1f,
+	0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x1b, 0x0a,
+	0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0x5d, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x1b, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a,
+	0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x40, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x3c, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x47, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x1f, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x54, 0x6f, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x08, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x02, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x55, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x75,
+	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0a,
+	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x02, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03,
+	0x73, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c,
+	0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68,
+	0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x65, 0x78, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x3c, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x31, 0x0a, 0x10, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x44, 0x69,
+	0x66, 0x66, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x12,
+	0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64,
+	0x61, 0x79, 0x44, 0x69, 0x66, 0x66, 0x44, 0x61, 0x79, 0x73, 0x22, 0x67, 0x0a, 0x13, 0x50, 0x6f,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x50, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0a,
+	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x1f, 0x0a,
+	0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x5d, 0x0a, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x14, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x64, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x19, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x5f,
+	0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xbb, 0x03, 0x0a, 0x17, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x54, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x12, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x73, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x14, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64,
+	0x73, 0x12, 0x50, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53,
+	0x54, 0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x41,
+	0x4c, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x53, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45,
+	0x4e, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x09, 0x0a,
+	0x05, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x06, 0x22, 0x92, 0x01, 0x0a, 0x1a, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf6, 0x01,
+	0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x12, 0x3e, 0x0a, 0x19, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x1c, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4a,
+	0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x01, 0x22, 0xb9, 0x02, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x51, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x39, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x50,
+	0x68, 0x6f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x68, 0x61,
+	0x73, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x65,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x73, 0x65, 0x78, 0x88, 0x01,
+	0x01, 0x22, 0x29, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43,
+	0x53, 0x56, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x02, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x68, 0x61, 0x73, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73,
+	0x65, 0x78, 0x22, 0x28, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x53, 0x0a, 0x13,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xa5, 0x02, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3b, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x03, 0x22, 0x94, 0x01, 0x0a, 0x19, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0c, 0x61, 0x6c,
+	0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x22, 0x51, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x69, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x5a, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x50, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x42, 0x28, 0x5a, 0x26, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_admin_movies_persons_service_v1_messages_proto_rawDescOnce sync.Once
+	file_admin_movies_persons_service_v1_messages_proto_rawDescData = file_admin_movies_persons_service_v1_messages_proto_rawDesc
+)
+
+func file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP() []byte {
+	file_admin_movies_persons_service_v1_messages_proto_rawDescOnce.Do(func() {
+		file_admin_movies_persons_service_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_movies_persons_service_v1_messages_proto_rawDescData)
+	})
+	return file_admin_movies_persons_service_v1_messages_proto_rawDescData
+}
+
+var file_admin_movies_persons_service_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_admin_movies_persons_service_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_admin_movies_persons_service_v1_messages_proto_goTypes = []interface{}{
+	(SearchPersonByNameRequest_SearchMode)(0), // 0: admin_movies_persons_service.SearchPersonByNameRequest.SearchMode
+	(BatchCreatePersonResult_Status)(0),       // 1: admin_movies_persons_service.BatchCreatePersonResult.Status
+	(ImportPersonsRequest_Format)(0),          // 2: admin_movies_persons_service.ImportPersonsRequest.Format
+	(ExportPersonsRequest_Format)(0),          // 3: admin_movies_persons_service.ExportPersonsRequest.Format
+	(PersonChange_Type)(0),                    // 4: admin_movies_persons_service.PersonChange.Type
+	(*SearchPersonRequest)(nil),               // 5: admin_movies_persons_service.SearchPersonRequest
+	(*SearchPersonByNameRequest)(nil),         // 6: admin_movies_persons_service.SearchPersonByNameRequest
+	(*DeletePersonsResponce)(nil),             // 7: admin_movies_persons_service.DeletePersonsResponce
+	(*GetPersonsRequest)(nil),                 // 8: admin_movies_persons_service.GetPersonsRequest
+	(*CreatePersonResponce)(nil),              // 9: admin_movies_persons_service.CreatePersonResponce
+	(*IsPersonsExistsRequest)(nil),            // 10: admin_movies_persons_service.IsPersonsExistsRequest
+	(*IsPersonsExistsResponse)(nil),           // 11: admin_movies_persons_service.IsPersonsExistsResponse
+	(*UpdatePersonFieldsRequest)(nil),         // 12: admin_movies_persons_service.UpdatePersonFieldsRequest
+	(*UpdatePersonRequest)(nil),               // 13: admin_movies_persons_service.UpdatePersonRequest
+	(*CreatePersonRequest)(nil),               // 14: admin_movies_persons_service.CreatePersonRequest
+	(*DeletePersonsRequest)(nil),              // 15: admin_movies_persons_service.DeletePersonsRequest
+	(*RestorePersonsRequest)(nil),             // 16: admin_movies_persons_service.RestorePersonsRequest
+	(*RestorePersonsResponse)(nil),            // 17: admin_movies_persons_service.RestorePersonsResponse
+	(*ReemitPersonsEventsRequest)(nil),        // 18: admin_movies_persons_service.ReemitPersonsEventsRequest
+	(*ReemitPersonsEventsResponse)(nil),       // 19: admin_movies_persons_service.ReemitPersonsEventsResponse
+	(*IsPersonWithIDExistsResponse)(nil),      // 20: admin_movies_persons_service.IsPersonWithIDExistsResponse
+	(*IsPersonWithIDExistsRequest)(nil),       // 21: admin_movies_persons_service.IsPersonWithIDExistsRequest
+	(*IsPersonExistsResponse)(nil),            // 22: admin_movies_persons_service.IsPersonExistsResponse
+	(*IsPersonExistsRequest)(nil),             // 23: admin_movies_persons_service.IsPersonExistsRequest
+	(*Person)(nil),                            // 24: admin_movies_persons_service.Person
+	(*GetPersonRequest)(nil),                  // 25: admin_movies_persons_service.GetPersonRequest
+	(*PersonDetails)(nil),                     // 26: admin_movies_persons_service.PersonDetails
+	(*Persons)(nil),                           // 27: admin_movies_persons_service.Persons
+	(*PersonsList)(nil),                       // 28: admin_movies_persons_service.PersonsList
+	(*ListPersonRevisionsRequest)(nil),        // 29: admin_movies_persons_service.ListPersonRevisionsRequest
+	(*PersonRevision)(nil),                    // 30: admin_movies_persons_service.PersonRevision
+	(*PersonRevisions)(nil),                   // 31: admin_movies_persons_service.PersonRevisions
+	(*GetPersonRevisionDiffRequest)(nil),      // 32: admin_movies_persons_service.GetPersonRevisionDiffRequest
+	(*PersonFieldDiff)(nil),                   // 33: admin_movies_persons_service.PersonFieldDiff
+	(*PersonRevisionDiff)(nil),                // 34: admin_movies_persons_service.PersonRevisionDiff
+	(*RollbackPersonToRevisionRequest)(nil),   // 35: admin_movies_persons_service.RollbackPersonToRevisionRequest
+	(*FindPotentialDuplicatesRequest)(nil),    // 36: admin_movies_persons_service.FindPotentialDuplicatesRequest
+	(*PotentialDuplicate)(nil),                // 37: admin_movies_persons_service.PotentialDuplicate
+	(*PotentialDuplicates)(nil),               // 38: admin_movies_persons_service.PotentialDuplicates
+	(*MergeFieldResolution)(nil),              // 39: admin_movies_persons_service.MergeFieldResolution
+	(*MergePersonsRequest)(nil),               // 40: admin_movies_persons_service.MergePersonsRequest
+	(*MergePersonsResponse)(nil),              // 41: admin_movies_persons_service.MergePersonsResponse
+	(*BatchCreatePersonsRequest)(nil),         // 42: admin_movies_persons_service.BatchCreatePersonsRequest
+	(*BatchCreatePersonResult)(nil),           // 43: admin_movies_persons_service.BatchCreatePersonResult
+	(*BatchCreatePersonsResponse)(nil),        // 44: admin_movies_persons_service.BatchCreatePersonsResponse
+	(*ImportPersonsRequest)(nil),              // 45: admin_movies_persons_service.ImportPersonsRequest
+	(*ExportPersonsRequest)(nil),              // 46: admin_movies_persons_service.ExportPersonsRequest
+	(*ExportPersonsChunk)(nil),                // 47: admin_movies_persons_service.ExportPersonsChunk
+	(*WatchPersonsRequest)(nil),               // 48: admin_movies_persons_service.WatchPersonsRequest
+	(*PersonChange)(nil),                      // 49: admin_movies_persons_service.PersonChange
+	(*BatchUpdatePersonsRequest)(nil),         // 50: admin_movies_persons_service.BatchUpdatePersonsRequest
+	(*InvalidPersonUpdate)(nil),               // 51: admin_movies_persons_service.InvalidPersonUpdate
+	(*BatchUpdatePersonsResponse)(nil),        // 52: admin_movies_persons_service.BatchUpdatePersonsResponse
+	(*UserErrorMessage)(nil),                  // 53: admin_movies_persons_service.UserErrorMessage
+	nil,                                       // 54: admin_movies_persons_service.IsPersonsExistsResponse.RedirectedIDsEntry
+	nil,                                       // 55: admin_movies_persons_service.Persons.PersonsEntry
+	nil,                                       // 56: admin_movies_persons_service.Persons.RedirectedIDsEntry
+	nil,                                       // 57: admin_movies_persons_service.PersonsList.RedirectedIDsEntry
+	(*timestamppb.Timestamp)(nil),             // 58: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 59: google.protobuf.FieldMask
+}
+var file_admin_movies_persons_service_v1_messages_proto_depIdxs = []int32{
+	58, // 0: admin_movies_persons_service.SearchPersonRequest.birthday:type_name -> google.protobuf.Timestamp
+	0,  // 1: admin_movies_persons_service.SearchPersonByNameRequest.mode:type_name -> admin_movies_persons_service.SearchPersonByNameRequest.SearchMode
+	58, // 2: admin_movies_persons_service.GetPersonsRequest.createdAfter:type_name -> google.protobuf.Timestamp
+	58, // 3: admin_movies_persons_service.GetPersonsRequest.createdBefore:type_name -> google.protobuf.Timestamp
+	58, // 4: admin_movies_persons_service.GetPersonsRequest.updatedAfter:type_name -> google.protobuf.Timestamp
+	58, // 5: admin_movies_persons_service.GetPersonsRequest.updatedBefore:type_name -> google.protobuf.Timestamp
+	54, // 6: admin_movies_persons_service.IsPersonsExistsResponse.RedirectedIDs:type_name -> admin_movies_persons_service.IsPersonsExistsResponse.RedirectedIDsEntry
+	58, // 7: admin_movies_persons_service.UpdatePersonFieldsRequest.birthday:type_name -> google.protobuf.Timestamp
+	59, // 8: admin_movies_persons_service.UpdatePersonFieldsRequest.updateMask:type_name -> google.protobuf.FieldMask
+	58, // 9: admin_movies_persons_service.UpdatePersonRequest.birthday:type_name -> google.protobuf.Timestamp
+	58, // 10: admin_movies_persons_service.CreatePersonRequest.birthday:type_name -> google.protobuf.Timestamp
+	58, // 11: admin_movies_persons_service.IsPersonExistsRequest.birthday:type_name -> google.protobuf.Timestamp
+	58, // 12: admin_movies_persons_service.Person.createdAt:type_name -> google.protobuf.Timestamp
+	58, // 13: admin_movies_persons_service.Person.updatedAt:type_name -> google.protobuf.Timestamp
+	58, // 14: admin_movies_persons_service.PersonDetails.createdAt:type_name -> google.protobuf.Timestamp
+	58, // 15: admin_movies_persons_service.PersonDetails.updatedAt:type_name -> google.protobuf.Timestamp
+	55, // 16: admin_movies_persons_service.Persons.persons:type_name -> admin_movies_persons_service.Persons.PersonsEntry
+	56, // 17: admin_movies_persons_service.Persons.redirectedIDs:type_name -> admin_movies_persons_service.Persons.RedirectedIDsEntry
+	24, // 18: admin_movies_persons_service.PersonsList.persons:type_name -> admin_movies_persons_service.Person
+	57, // 19: admin_movies_persons_service.PersonsList.redirectedIDs:type_name -> admin_movies_persons_service.PersonsList.RedirectedIDsEntry
+	58, // 20: admin_movies_persons_service.PersonRevision.changedAt:type_name -> google.protobuf.Timestamp
+	30, // 21: admin_movies_persons_service.PersonRevisions.revisions:type_name -> admin_movies_persons_service.PersonRevision
+	30, // 22: admin_movies_persons_service.PersonRevisionDiff.from:type_name -> admin_movies_persons_service.PersonRevision
+	30, // 23: admin_movies_persons_service.PersonRevisionDiff.to:type_name -> admin_movies_persons_service.PersonRevision
+	33, // 24: admin_movies_persons_service.PersonRevisionDiff.changes:type_name -> admin_movies_persons_service.PersonFieldDiff
+	58, // 25: admin_movies_persons_service.FindPotentialDuplicatesRequest.birthday:type_name -> google.protobuf.Timestamp
+	24, // 26: admin_movies_persons_service.PotentialDuplicate.person:type_name -> admin_movies_persons_service.Person
+	37, // 27: admin_movies_persons_service.PotentialDuplicates.duplicates:type_name -> admin_movies_persons_service.PotentialDuplicate
+	39, // 28: admin_movies_persons_service.MergePersonsRequest.fieldResolution:type_name -> admin_movies_persons_service.MergeFieldResolution
+	14, // 29: admin_movies_persons_service.BatchCreatePersonsRequest.persons:type_name -> admin_movies_persons_service.CreatePersonRequest
+	1,  // 30: admin_movies_persons_service.BatchCreatePersonResult.status:type_name -> admin_movies_persons_service.BatchCreatePersonResult.Status
+	37, // 31: admin_movies_persons_service.BatchCreatePersonResult.duplicates:type_name -> admin_movies_persons_service.PotentialDuplicate
+	43, // 32: admin_movies_persons_service.BatchCreatePersonsResponse.results:type_name -> admin_movies_persons_service.BatchCreatePersonResult
+	2,  // 33: admin_movies_persons_service.ImportPersonsRequest.format:type_name -> admin_movies_persons_service.ImportPersonsRequest.Format
+	3,  // 34: admin_movies_persons_service.ExportPersonsRequest.format:type_name -> admin_movies_persons_service.ExportPersonsRequest.Format
+	58, // 35: admin_movies_persons_service.ExportPersonsRequest.updatedSince:type_name -> google.protobuf.Timestamp
+	4,  // 36: admin_movies_persons_service.PersonChange.type:type_name -> admin_movies_persons_service.PersonChange.Type
+	24, // 37: admin_movies_persons_service.PersonChange.person:type_name -> admin_movies_persons_service.Person
+	58, // 38: admin_movies_persons_service.PersonChange.changedAt:type_name -> google.protobuf.Timestamp
+	12, // 39: admin_movies_persons_service.BatchUpdatePersonsRequest.updates:type_name -> admin_movies_persons_service.UpdatePersonFieldsRequest
+	51, // 40: admin_movies_persons_service.BatchUpdatePersonsResponse.invalidUpdates:type_name -> admin_movies_persons_service.InvalidPersonUpdate
+	37, // 41: admin_movies_persons_service.UserErrorMessage.duplicates:type_name -> admin_movies_persons_service.PotentialDuplicate
+	24, // 42: admin_movies_persons_service.Persons.PersonsEntry.value:type_name -> admin_movies_persons_service.Person
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_admin_movies_persons_service_v1_messages_proto_init() }
+func file_admin_movies_persons_service_v1_messages_proto_init() {
+	if File_admin_movies_persons_service_v1_messages_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPersonByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePersonsResponce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePersonResponce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPersonsExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPersonsExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePersonFieldsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePersonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePersonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePersonsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReemitPersonsEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReemitPersonsEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPersonWithIDExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPersonWithIDExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPersonExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPersonExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Person); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Persons); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UserErrorMessage.ProtoReflect.Descriptor instead.
-func (*UserErrorMessage) Descriptor() ([]byte, []int) {
-	return file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *UserErrorMessage) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-var File_admin_movies_persons_service_v1_messages_proto protoreflect.FileDescriptor
-
-var file_admin_movies_persons_service_v1_messages_proto_rawDesc = []byte{
-	0x0a, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76,
-	0x31, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x1c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xb7, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e,
-	0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x66,
-	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
-	0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x48, 0x02, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01, 0x01,
-	0x12, 0x15, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
-	0x03, 0x73, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x04, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x88,
-	0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62,
-	0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x65, 0x78, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a,
-	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x5e, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69,
-	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x22, 0x39, 0x0a, 0x16, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x64, 0x0a, 0x17,
-	0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x22,
-	0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x72, 0x75, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61,
-	0x6d, 0x65, 0x45, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x66, 0x75,
-	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08,
-	0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x08, 0x62, 0x69,
-	0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x65, 0x78,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x73, 0x65, 0x78, 0x88, 0x01, 0x01,
-	0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48,
-	0x04, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66,
-	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69,
-	0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x65, 0x78, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72,
-	0x75, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x65, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
-	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x68, 0x6f,
-	0x74, 0x6f, 0x22, 0xf9, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x75,
-	0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x24, 0x0a, 0x0a, 0x66,
-	0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x3b, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
-	0x01, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x15,
-	0x0a, 0x03, 0x73, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x73,
-	0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x03, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x88, 0x01, 0x01,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04,
-	0x5f, 0x73, 0x65, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0x37,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x73, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x1c, 0x49, 0x73, 0x50, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x1b,
-	0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x16, 0x49, 0x73, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x65,
-	0x64, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x05, 0x52, 0x12, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x15, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73,
-	0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x72, 0x75, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d,
-	0x65, 0x45, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x66, 0x75, 0x6c,
-	0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x62,
-	0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x08, 0x62, 0x69, 0x72,
-	0x74, 0x68, 0x64, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x65, 0x78, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x73, 0x65, 0x78, 0x88, 0x01, 0x01, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73,
-	0x65, 0x78, 0x22, 0x95, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
-	0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x12, 0x1f,
-	0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x4e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x78, 0x12, 0x1b, 0x0a,
-	0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xb9, 0x01, 0x0a, 0x07, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
-	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x2e, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x65, 0x72,
-	0x73, 0x6f, 0x6e, 0x73, 0x1a, 0x60, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
-	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2c, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x28, 0x5a, 0x26, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
-	0x76, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_admin_movies_persons_service_v1_messages_proto_rawDescOnce sync.Once
-	file_admin_movies_persons_service_v1_messages_proto_rawDescData = file_admin_movies_persons_service_v1_messages_proto_rawDesc
-)
-
-func file_admin_movies_persons_service_v1_messages_proto_rawDescGZIP() []byte {
-	file_admin_movies_persons_service_v1_messages_proto_rawDescOnce.Do(func() {
-		file_admin_movies_persons_service_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_movies_persons_service_v1_messages_proto_rawDescData)
-	})
-	return file_admin_movies_persons_service_v1_messages_proto_rawDescData
-}
-
-var file_admin_movies_persons_service_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_admin_movies_persons_service_v1_messages_proto_goTypes = []interface{}{
-	(*SearchPersonRequest)(nil),          // 0: admin_movies_persons_service.SearchPersonRequest
-	(*SearchPersonByNameRequest)(nil),    // 1: admin_movies_persons_service.SearchPersonByNameRequest
-	(*DeletePersonsResponce)(nil),        // 2: admin_movies_persons_service.DeletePersonsResponce
-	(*GetPersonsRequest)(nil),            // 3: admin_movies_persons_service.GetPersonsRequest
-	(*CreatePersonResponce)(nil),         // 4: admin_movies_persons_service.CreatePersonResponce
-	(*IsPersonsExistsRequest)(nil),       // 5: admin_movies_persons_service.IsPersonsExistsRequest
-	(*IsPersonsExistsResponse)(nil),      // 6: admin_movies_persons_service.IsPersonsExistsResponse
-	(*UpdatePersonFieldsRequest)(nil),    // 7: admin_movies_persons_service.UpdatePersonFieldsRequest
-	(*UpdatePersonRequest)(nil),          // 8: admin_movies_persons_service.UpdatePersonRequest
-	(*CreatePersonRequest)(nil),          // 9: admin_movies_persons_service.CreatePersonRequest
-	(*DeletePersonsRequest)(nil),         // 10: admin_movies_persons_service.DeletePersonsRequest
-	(*IsPersonWithIDExistsResponse)(nil), // 11: admin_movies_persons_service.IsPersonWithIDExistsResponse
-	(*IsPersonWithIDExistsRequest)(nil),  // 12: admin_movies_persons_service.IsPersonWithIDExistsRequest
-	(*IsPersonExistsResponse)(nil),       // 13: admin_movies_persons_service.IsPersonExistsResponse
-	(*IsPersonExistsRequest)(nil),        // 14: admin_movies_persons_service.IsPersonExistsRequest
-	(*Person)(nil),                       // 15: admin_movies_persons_service.Person
-	(*Persons)(nil),                      // 16: admin_movies_persons_service.Persons
-	(*UserErrorMessage)(nil),             // 17: admin_movies_persons_service.UserErrorMessage
-	nil,                                  // 18: admin_movies_persons_service.Persons.PersonsEntry
-	(*timestamppb.Timestamp)(nil),        // 19: google.protobuf.Timestamp
-}
-var file_admin_movies_persons_service_v1_messages_proto_depIdxs = []int32{
-	19, // 0: admin_movies_persons_service.SearchPersonRequest.birthday:type_name -> google.protobuf.Timestamp
-	19, // 1: admin_movies_persons_service.UpdatePersonFieldsRequest.birthday:type_name -> google.protobuf.Timestamp
-	19, // 2: admin_movies_persons_service.UpdatePersonRequest.birthday:type_name -> google.protobuf.Timestamp
-	19, // 3: admin_movies_persons_service.CreatePersonRequest.birthday:type_name -> google.protobuf.Timestamp
-	19, // 4: admin_movies_persons_service.IsPersonExistsRequest.birthday:type_name -> google.protobuf.Timestamp
-	18, // 5: admin_movies_persons_service.Persons.persons:type_name -> admin_movies_persons_service.Persons.PersonsEntry
-	15, // 6: admin_movies_persons_service.Persons.PersonsEntry.value:type_name -> admin_movies_persons_service.Person
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
-}
-
-func init() { file_admin_movies_persons_service_v1_messages_proto_init() }
-func file_admin_movies_persons_service_v1_messages_proto_init() {
-	if File_admin_movies_persons_service_v1_messages_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchPersonRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPersonRevisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1379,8 +4734,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchPersonByNameRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1391,8 +4746,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePersonsResponce); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonRevisions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1403,8 +4758,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPersonsRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonRevisionDiffRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1415,8 +4770,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePersonResponce); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonFieldDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1427,8 +4782,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPersonsExistsRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonRevisionDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1439,8 +4794,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPersonsExistsResponse); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackPersonToRevisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1451,8 +4806,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePersonFieldsRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPotentialDuplicatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1463,8 +4818,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePersonRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PotentialDuplicate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1475,8 +4830,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePersonRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PotentialDuplicates); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1487,8 +4842,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePersonsRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeFieldResolution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1499,8 +4854,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPersonWithIDExistsResponse); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePersonsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1511,8 +4866,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPersonWithIDExistsRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergePersonsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1523,8 +4878,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPersonExistsResponse); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreatePersonsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1535,8 +4890,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPersonExistsRequest); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreatePersonResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1547,8 +4902,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Person); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreatePersonsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1559,8 +4914,8 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Persons); i {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPersonsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1571,7 +4926,91 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_admin_movies_persons_service_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPersonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPersonsChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPersonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdatePersonsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidPersonUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdatePersonsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_movies_persons_service_v1_messages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserErrorMessage); i {
 			case 0:
 				return &v.state
@@ -1586,20 +5025,26 @@ func file_admin_movies_persons_service_v1_messages_proto_init() {
 	}
 	file_admin_movies_persons_service_v1_messages_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_admin_movies_persons_service_v1_messages_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_admin_movies_persons_service_v1_messages_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	file_admin_movies_persons_service_v1_messages_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_admin_movies_persons_service_v1_messages_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_admin_movies_persons_service_v1_messages_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_admin_movies_persons_service_v1_messages_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_admin_movies_persons_service_v1_messages_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_admin_movies_persons_service_v1_messages_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_admin_movies_persons_service_v1_messages_proto_msgTypes[43].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_movies_persons_service_v1_messages_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      5,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_admin_movies_persons_service_v1_messages_proto_goTypes,
 		DependencyIndexes: file_admin_movies_persons_service_v1_messages_proto_depIdxs,
+		EnumInfos:         file_admin_movies_persons_service_v1_messages_proto_enumTypes,
 		MessageInfos:      file_admin_movies_persons_service_v1_messages_proto_msgTypes,
 	}.Build()
 	File_admin_movies_persons_service_v1_messages_proto = out.File